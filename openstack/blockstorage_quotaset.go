@@ -2,9 +2,24 @@ package openstack
 
 import (
 	"fmt"
+	"sort"
 	"strconv"
+	"strings"
 )
 
+// blockStorageQuotasetID returns the resource ID of a quotaset, which is
+// suffixed with the region.
+func blockStorageQuotasetID(projectID, region string) string {
+	return fmt.Sprintf("%s/%s", projectID, region)
+}
+
+// blockStorageQuotasetProjectID parses the project ID from a quotaset
+// resource ID. Depending on the provider version the resource was created
+// with, the ID is either <project_id> or <project_id>/<region>.
+func blockStorageQuotasetProjectID(id string) string {
+	return strings.Split(id, "/")[0]
+}
+
 // blockStorageVolumeTypeQuotaConversion converts all values of the map to int.
 func blockStorageVolumeTypeQuotaConversion(vtq map[string]interface{}) (map[string]interface{}, error) {
 	newVTQ := make(map[string]interface{})
@@ -21,3 +36,88 @@ func blockStorageVolumeTypeQuotaConversion(vtq map[string]interface{}) (map[stri
 	}
 	return newVTQ, nil
 }
+
+// blockStorageVolumeTypeQuotaUpdate returns the volume type quotas to send,
+// when volume_type_quota changed from o to n. Cinder keeps entries which
+// aren't sent, so removed entries are reset to the value returned by
+// defaults, or -1 (unlimited) when there's no default for them. defaults is
+// only called when entries were removed.
+func blockStorageVolumeTypeQuotaUpdate(o, n map[string]interface{}, defaults func() (map[string]interface{}, error)) (map[string]interface{}, error) {
+	update, err := blockStorageVolumeTypeQuotaConversion(n)
+	if err != nil {
+		return nil, err
+	}
+
+	var removed []string
+	for k := range o {
+		if _, ok := n[k]; !ok {
+			removed = append(removed, k)
+		}
+	}
+	if len(removed) == 0 {
+		return update, nil
+	}
+
+	defaultQuota, err := defaults()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, k := range removed {
+		update[k] = -1
+		if f, ok := defaultQuota[k].(float64); ok {
+			update[k] = int(f)
+		}
+	}
+
+	return update, nil
+}
+
+// flattenBlockStorageQuotasetUsage flattens the raw quota usage, which also
+// contains the per volume type usage, e.g. "volumes_lvmdriver-1". Entries,
+// which aren't a usage, e.g. the "id", are skipped.
+func flattenBlockStorageQuotasetUsage(usage map[string]interface{}) []map[string]interface{} {
+	names := make([]string, 0, len(usage))
+	for name, v := range usage {
+		if _, ok := v.(map[string]interface{}); ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	res := make([]map[string]interface{}, len(names))
+	for i, name := range names {
+		v := usage[name].(map[string]interface{})
+		res[i] = map[string]interface{}{
+			"resource":  name,
+			"in_use":    blockStorageQuotasetUsageValue(v["in_use"]),
+			"allocated": blockStorageQuotasetUsageValue(v["allocated"]),
+			"reserved":  blockStorageQuotasetUsageValue(v["reserved"]),
+			"limit":     blockStorageQuotasetUsageValue(v["limit"]),
+		}
+	}
+
+	return res
+}
+
+func blockStorageQuotasetUsageValue(v interface{}) int {
+	if f, ok := v.(float64); ok {
+		return int(f)
+	}
+
+	return 0
+}
+
+// flattenBlockStorageVolumeTypeQuota converts all values of the map to string.
+func flattenBlockStorageVolumeTypeQuota(vtq map[string]interface{}) map[string]string {
+	res := make(map[string]string, len(vtq))
+	for k, v := range vtq {
+		if f, ok := v.(float64); ok {
+			res[k] = strconv.Itoa(int(f))
+			continue
+		}
+		res[k] = fmt.Sprintf("%v", v)
+	}
+
+	return res
+}
@@ -1,6 +1,7 @@
 package openstack
 
 import (
+	"fmt"
 	"reflect"
 	"testing"
 )
@@ -52,3 +53,120 @@ func TestBlockStorageVolumeTypeQuotaConversion_err2(t *testing.T) {
 		t.Fatal("Expected error in converting to int")
 	}
 }
+
+func TestFlattenBlockStorageQuotasetUsage(t *testing.T) {
+	usage := map[string]interface{}{
+		"id": "2e367a3d29f94fd988e6ec54e305ec9d",
+		"gigabytes": map[string]interface{}{
+			"in_use":    float64(10),
+			"allocated": float64(0),
+			"reserved":  float64(1),
+			"limit":     float64(100),
+		},
+		"volumes_lvmdriver-1": map[string]interface{}{
+			"in_use": float64(2),
+			"limit":  float64(-1),
+		},
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"resource":  "gigabytes",
+			"in_use":    10,
+			"allocated": 0,
+			"reserved":  1,
+			"limit":     100,
+		},
+		{
+			"resource":  "volumes_lvmdriver-1",
+			"in_use":    2,
+			"allocated": 0,
+			"reserved":  0,
+			"limit":     -1,
+		},
+	}
+
+	actual := flattenBlockStorageQuotasetUsage(usage)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("Results differ. Want: %#v, but got %#v", expected, actual)
+	}
+}
+
+func TestFlattenBlockStorageVolumeTypeQuota(t *testing.T) {
+	raw := map[string]interface{}{
+		"volumes_foo":   float64(42),
+		"gigabytes_foo": float64(-1),
+	}
+
+	expected := map[string]string{
+		"volumes_foo":   "42",
+		"gigabytes_foo": "-1",
+	}
+
+	actual := flattenBlockStorageVolumeTypeQuota(raw)
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("Results differ. Want: %#v, but got %#v", expected, actual)
+	}
+}
+
+func TestBlockStorageQuotasetID(t *testing.T) {
+	id := blockStorageQuotasetID("b0d5e7c1f0a34d3a9c2e1f6a7b8c9d0e", "RegionOne")
+	if id != "b0d5e7c1f0a34d3a9c2e1f6a7b8c9d0e/RegionOne" {
+		t.Fatalf("Unexpected quotaset ID: %s", id)
+	}
+
+	for _, id := range []string{id, "b0d5e7c1f0a34d3a9c2e1f6a7b8c9d0e"} {
+		projectID := blockStorageQuotasetProjectID(id)
+		if projectID != "b0d5e7c1f0a34d3a9c2e1f6a7b8c9d0e" {
+			t.Fatalf("Unexpected project ID parsed from %s: %s", id, projectID)
+		}
+	}
+}
+
+func TestBlockStorageVolumeTypeQuotaUpdate(t *testing.T) {
+	o := map[string]interface{}{
+		"volumes_foo":   "10",
+		"snapshots_foo": "10",
+		"gigabytes_foo": "10",
+	}
+	n := map[string]interface{}{
+		"volumes_foo": "20",
+	}
+	defaults := func() (map[string]interface{}, error) {
+		return map[string]interface{}{
+			"snapshots_foo": float64(5),
+		}, nil
+	}
+
+	expected := map[string]interface{}{
+		"volumes_foo":   20,
+		"snapshots_foo": 5,
+		"gigabytes_foo": -1,
+	}
+
+	actual, err := blockStorageVolumeTypeQuotaUpdate(o, n, defaults)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("Result was %#v instead of %#v", actual, expected)
+	}
+
+	noDefaults := func() (map[string]interface{}, error) {
+		return nil, fmt.Errorf("defaults must not be retrieved")
+	}
+
+	expected = map[string]interface{}{
+		"volumes_foo": 20,
+	}
+
+	actual, err = blockStorageVolumeTypeQuotaUpdate(n, n, noDefaults)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("Result was %#v instead of %#v", actual, expected)
+	}
+}
@@ -0,0 +1,58 @@
+package openstack
+
+import (
+	"sort"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/availabilityzones"
+)
+
+// flattenComputeAvailabilityZonesV2Detail converts detailed availability
+// zones, ordering zones, hosts and services by name.
+func flattenComputeAvailabilityZonesV2Detail(zones []availabilityzones.AvailabilityZone) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(zones))
+
+	for _, zone := range zones {
+		hostNames := make([]string, 0, len(zone.Hosts))
+		for name := range zone.Hosts {
+			hostNames = append(hostNames, name)
+		}
+		sort.Strings(hostNames)
+
+		hosts := make([]map[string]interface{}, 0, len(hostNames))
+		for _, hostName := range hostNames {
+			services := zone.Hosts[hostName]
+
+			serviceNames := make([]string, 0, len(services))
+			for name := range services {
+				serviceNames = append(serviceNames, name)
+			}
+			sort.Strings(serviceNames)
+
+			flattenedServices := make([]map[string]interface{}, 0, len(serviceNames))
+			for _, serviceName := range serviceNames {
+				flattenedServices = append(flattenedServices, map[string]interface{}{
+					"name":      serviceName,
+					"active":    services[serviceName].Active,
+					"available": services[serviceName].Available,
+				})
+			}
+
+			hosts = append(hosts, map[string]interface{}{
+				"name":     hostName,
+				"services": flattenedServices,
+			})
+		}
+
+		result = append(result, map[string]interface{}{
+			"name":      zone.ZoneName,
+			"available": zone.ZoneState.Available,
+			"hosts":     hosts,
+		})
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i]["name"].(string) < result[j]["name"].(string)
+	})
+
+	return result
+}
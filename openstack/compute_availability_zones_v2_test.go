@@ -0,0 +1,71 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/availabilityzones"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlattenComputeAvailabilityZonesV2Detail(t *testing.T) {
+	zones := []availabilityzones.AvailabilityZone{
+		{
+			ZoneName:  "nova",
+			ZoneState: availabilityzones.ZoneState{Available: true},
+			Hosts: availabilityzones.Hosts{
+				"compute-2": availabilityzones.Services{
+					"nova-compute": availabilityzones.ServiceState{Active: true, Available: false},
+				},
+				"compute-1": availabilityzones.Services{
+					"nova-compute": availabilityzones.ServiceState{Active: true, Available: true},
+				},
+			},
+		},
+		{
+			ZoneName:  "internal",
+			ZoneState: availabilityzones.ZoneState{Available: true},
+			Hosts: availabilityzones.Hosts{
+				"controller": availabilityzones.Services{
+					"nova-scheduler": availabilityzones.ServiceState{Active: true, Available: true},
+					"nova-conductor": availabilityzones.ServiceState{Active: false, Available: true},
+				},
+			},
+		},
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"name":      "internal",
+			"available": true,
+			"hosts": []map[string]interface{}{
+				{
+					"name": "controller",
+					"services": []map[string]interface{}{
+						{"name": "nova-conductor", "active": false, "available": true},
+						{"name": "nova-scheduler", "active": true, "available": true},
+					},
+				},
+			},
+		},
+		{
+			"name":      "nova",
+			"available": true,
+			"hosts": []map[string]interface{}{
+				{
+					"name": "compute-1",
+					"services": []map[string]interface{}{
+						{"name": "nova-compute", "active": true, "available": true},
+					},
+				},
+				{
+					"name": "compute-2",
+					"services": []map[string]interface{}{
+						{"name": "nova-compute", "active": true, "available": false},
+					},
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, expected, flattenComputeAvailabilityZonesV2Detail(zones))
+}
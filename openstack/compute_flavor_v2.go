@@ -1,6 +1,8 @@
 package openstack
 
 import (
+	"sort"
+
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/flavors"
 )
 
@@ -12,3 +14,26 @@ func expandComputeFlavorV2ExtraSpecs(raw map[string]interface{}) flavors.ExtraSp
 
 	return extraSpecs
 }
+
+// computeFlavorV2Smallest returns the smallest flavor ordered by vcpus, ram and
+// disk. The name breaks ties so the result doesn't depend on the API order.
+func computeFlavorV2Smallest(allFlavors []flavors.Flavor) flavors.Flavor {
+	sorted := make([]flavors.Flavor, len(allFlavors))
+	copy(sorted, allFlavors)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		if a.VCPUs != b.VCPUs {
+			return a.VCPUs < b.VCPUs
+		}
+		if a.RAM != b.RAM {
+			return a.RAM < b.RAM
+		}
+		if a.Disk != b.Disk {
+			return a.Disk < b.Disk
+		}
+		return a.Name < b.Name
+	})
+
+	return sorted[0]
+}
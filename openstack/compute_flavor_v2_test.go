@@ -24,3 +24,18 @@ func TestExpandComputeFlavorV2ExtraSpecs(t *testing.T) {
 		t.Fatalf("Results differ. Want: %#v, but got %#v", expected, actual)
 	}
 }
+
+func TestComputeFlavorV2Smallest(t *testing.T) {
+	allFlavors := []flavors.Flavor{
+		{Name: "m1.large", VCPUs: 4, RAM: 8192, Disk: 80},
+		{Name: "m1.small-b", VCPUs: 1, RAM: 2048, Disk: 20},
+		{Name: "m1.medium", VCPUs: 2, RAM: 4096, Disk: 40},
+		{Name: "m1.small-a", VCPUs: 1, RAM: 2048, Disk: 20},
+		{Name: "m1.small-disk", VCPUs: 1, RAM: 2048, Disk: 40},
+	}
+
+	actual := computeFlavorV2Smallest(allFlavors)
+	if actual.Name != "m1.small-a" {
+		t.Fatalf("expected m1.small-a, got %s", actual.Name)
+	}
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/tenantnetworks"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/floatingips"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/networks"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -52,6 +53,7 @@ type InstanceNetwork struct {
 	Name          string
 	Port          string
 	FixedIP       string
+	FloatingIP    string
 	AccessNetwork bool
 }
 
@@ -99,6 +101,7 @@ func getAllInstanceNetworks(d *schema.ResourceData, meta interface{}) ([]Instanc
 				Name:          networkName,
 				Port:          portID,
 				FixedIP:       network["fixed_ip_v4"].(string),
+				FloatingIP:    network["floating_ip"].(string),
 				AccessNetwork: network["access_network"].(bool),
 			}
 			instanceNetworks = append(instanceNetworks, v)
@@ -132,6 +135,7 @@ func getAllInstanceNetworks(d *schema.ResourceData, meta interface{}) ([]Instanc
 		v := InstanceNetwork{
 			Port:          portID,
 			FixedIP:       network["fixed_ip_v4"].(string),
+			FloatingIP:    network["floating_ip"].(string),
 			AccessNetwork: network["access_network"].(bool),
 		}
 		if networkInfo["uuid"] != nil {
@@ -507,6 +511,7 @@ func flattenInstanceNetworks(d *schema.ResourceData, meta interface{}) ([]map[st
 					"mac":            instanceNIC.MAC,
 					"uuid":           instanceNetwork.UUID,
 					"port":           instanceNetwork.Port,
+					"floating_ip":    instanceNetwork.FloatingIP,
 					"access_network": instanceNetwork.AccessNetwork,
 				}
 				networks = append(networks, v)
@@ -554,6 +559,205 @@ func getInstanceAccessAddresses(d *schema.ResourceData, networks []map[string]in
 	return hostv4, hostv6
 }
 
+// computeV2InstanceFloatingIPChanges compares the floating_ip of each network
+// block and returns the addresses to disassociate, together with the
+// addresses to associate keyed by the network block index.
+func computeV2InstanceFloatingIPChanges(oldNetworks, newNetworks []interface{}) ([]string, map[int]string) {
+	var disassociate []string
+	associate := make(map[int]string)
+
+	count := len(newNetworks)
+	if len(oldNetworks) > count {
+		count = len(oldNetworks)
+	}
+
+	for i := 0; i < count; i++ {
+		var newFIP, oldFIP string
+		if i < len(newNetworks) {
+			if network, ok := newNetworks[i].(map[string]interface{}); ok {
+				newFIP, _ = network["floating_ip"].(string)
+			}
+		}
+		if i < len(oldNetworks) {
+			if network, ok := oldNetworks[i].(map[string]interface{}); ok {
+				oldFIP, _ = network["floating_ip"].(string)
+			}
+		}
+
+		if oldFIP == newFIP {
+			continue
+		}
+
+		if oldFIP != "" {
+			disassociate = append(disassociate, oldFIP)
+		}
+		if newFIP != "" {
+			associate[i] = newFIP
+		}
+	}
+
+	return disassociate, associate
+}
+
+// computeV2InstanceUpdateFloatingIPs associates and disassociates the
+// floating IPs set on the network blocks of an instance.
+func computeV2InstanceUpdateFloatingIPs(d *schema.ResourceData, meta interface{}, oldNetworks, newNetworks []interface{}) error {
+	disassociate, associate := computeV2InstanceFloatingIPChanges(oldNetworks, newNetworks)
+	if len(disassociate) == 0 && len(associate) == 0 {
+		return nil
+	}
+
+	config := meta.(*Config)
+	networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack network client: %s", err)
+	}
+
+	if len(disassociate) > 0 {
+		portIDs, err := computeV2InstancePortIDs(networkingClient, d.Id())
+		if err != nil {
+			return fmt.Errorf("Error retrieving ports of openstack_compute_instance_v2 %s: %s", d.Id(), err)
+		}
+
+		for _, floatingIP := range disassociate {
+			if err := computeV2InstanceDisassociateFloatingIP(networkingClient, floatingIP, portIDs); err != nil {
+				return fmt.Errorf("Error disassociating floating_ip %s from openstack_compute_instance_v2 %s: %s", floatingIP, d.Id(), err)
+			}
+		}
+	}
+
+	if len(associate) == 0 {
+		return nil
+	}
+
+	// The MAC address of each NIC is needed to find the port behind it.
+	networks, err := flattenInstanceNetworks(d, meta)
+	if err != nil {
+		return err
+	}
+
+	for i, floatingIP := range associate {
+		if i >= len(networks) {
+			return fmt.Errorf("Unable to find network %d of openstack_compute_instance_v2 %s to associate floating_ip %s", i, d.Id(), floatingIP)
+		}
+
+		mac, _ := networks[i]["mac"].(string)
+		fixedIP, _ := networks[i]["fixed_ip_v4"].(string)
+		if err := computeV2InstanceAssociateFloatingIP(networkingClient, d.Id(), mac, fixedIP, floatingIP); err != nil {
+			return fmt.Errorf("Error associating floating_ip %s with openstack_compute_instance_v2 %s: %s", floatingIP, d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+// computeV2InstanceAssociateFloatingIP associates an allocated floating IP
+// with the port of the instance NIC identified by its MAC address.
+func computeV2InstanceAssociateFloatingIP(networkingClient *gophercloud.ServiceClient, instanceID, mac, fixedIP, floatingIP string) error {
+	fipID, err := networkingFloatingIPV2ID(networkingClient, floatingIP)
+	if err != nil {
+		return err
+	}
+
+	fip, err := floatingips.Get(networkingClient, fipID).Extract()
+	if err != nil {
+		return err
+	}
+
+	listOpts := ports.ListOpts{
+		DeviceID:   instanceID,
+		MACAddress: mac,
+	}
+	allPages, err := ports.List(networkingClient, listOpts).AllPages()
+	if err != nil {
+		return err
+	}
+
+	allPorts, err := ports.ExtractPorts(allPages)
+	if err != nil {
+		return err
+	}
+
+	if len(allPorts) != 1 {
+		return fmt.Errorf("Expected one port with MAC %s, got %d", mac, len(allPorts))
+	}
+
+	portID := allPorts[0].ID
+	if fip.PortID != "" && fip.PortID != portID {
+		return fmt.Errorf("floating_ip is already associated with port %s", fip.PortID)
+	}
+
+	updateOpts := floatingips.UpdateOpts{
+		PortID:  &portID,
+		FixedIP: fixedIP,
+	}
+
+	log.Printf("[DEBUG] openstack_compute_instance_v2 %s floating_ip %s update options: %#v", instanceID, floatingIP, updateOpts)
+	_, err = floatingips.Update(networkingClient, fipID, updateOpts).Extract()
+
+	return err
+}
+
+// computeV2InstancePortIDs returns the IDs of the ports of an instance.
+func computeV2InstancePortIDs(networkingClient *gophercloud.ServiceClient, instanceID string) ([]string, error) {
+	allPages, err := ports.List(networkingClient, ports.ListOpts{DeviceID: instanceID}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+
+	allPorts, err := ports.ExtractPorts(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	portIDs := make([]string, len(allPorts))
+	for i, port := range allPorts {
+		portIDs[i] = port.ID
+	}
+
+	return portIDs, nil
+}
+
+// computeV2InstanceDisassociateFloatingIP removes the port association of a
+// floating IP, if it's associated with one of the instance ports. A floating
+// IP which no longer exists or has moved to another port is ignored.
+func computeV2InstanceDisassociateFloatingIP(networkingClient *gophercloud.ServiceClient, floatingIP string, portIDs []string) error {
+	allPages, err := floatingips.List(networkingClient, floatingips.ListOpts{FloatingIP: floatingIP}).AllPages()
+	if err != nil {
+		return err
+	}
+
+	allFloatingIPs, err := floatingips.ExtractFloatingIPs(allPages)
+	if err != nil {
+		return err
+	}
+
+	for _, fip := range allFloatingIPs {
+		if fip.PortID == "" {
+			continue
+		}
+
+		if !strSliceContains(portIDs, fip.PortID) {
+			log.Printf("[DEBUG] Not disassociating floating_ip %s from port %s, which doesn't belong to the instance", floatingIP, fip.PortID)
+			continue
+		}
+
+		updateOpts := floatingips.UpdateOpts{
+			PortID: new(string),
+		}
+
+		log.Printf("[DEBUG] Disassociating floating_ip %s from port %s", floatingIP, fip.PortID)
+		if _, err := floatingips.Update(networkingClient, fip.ID, updateOpts).Extract(); err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				continue
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func computeV2InstanceReadTags(d *schema.ResourceData, tags []string) {
 	expandObjectReadTags(d, tags)
 }
@@ -0,0 +1,88 @@
+package openstack
+
+import (
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	thclient "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComputeV2InstanceFloatingIPChanges(t *testing.T) {
+	oldNetworks := []interface{}{
+		map[string]interface{}{
+			"floating_ip": "192.0.2.10",
+		},
+		map[string]interface{}{
+			"floating_ip": "192.0.2.11",
+		},
+		map[string]interface{}{
+			"floating_ip": "",
+		},
+	}
+
+	newNetworks := []interface{}{
+		map[string]interface{}{
+			"floating_ip": "192.0.2.10",
+		},
+		map[string]interface{}{
+			"floating_ip": "",
+		},
+		map[string]interface{}{
+			"floating_ip": "192.0.2.12",
+		},
+	}
+
+	disassociate, associate := computeV2InstanceFloatingIPChanges(oldNetworks, newNetworks)
+	assert.Equal(t, []string{"192.0.2.11"}, disassociate)
+	assert.Equal(t, map[int]string{2: "192.0.2.12"}, associate)
+
+	disassociate, associate = computeV2InstanceFloatingIPChanges(nil, oldNetworks)
+	assert.Empty(t, disassociate)
+	assert.Equal(t, map[int]string{0: "192.0.2.10", 1: "192.0.2.11"}, associate)
+
+	disassociate, associate = computeV2InstanceFloatingIPChanges(oldNetworks, nil)
+	assert.Equal(t, []string{"192.0.2.10", "192.0.2.11"}, disassociate)
+	assert.Empty(t, associate)
+}
+
+func TestComputeV2InstanceDisassociateFloatingIP(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/floatingips", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+		switch r.URL.Query().Get("floating_ip_address") {
+		case "192.0.2.10":
+			fmt.Fprint(w, `{"floatingips": [{"id": "fip_1", "floating_ip_address": "192.0.2.10", "port_id": "port_1"}]}`)
+		case "192.0.2.11":
+			fmt.Fprint(w, `{"floatingips": [{"id": "fip_2", "floating_ip_address": "192.0.2.11", "port_id": "port_other"}]}`)
+		}
+	})
+
+	var updates int32
+	th.Mux.HandleFunc("/floatingips/fip_1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestJSONRequest(t, r, `{"floatingip": {"port_id": null}}`)
+		atomic.AddInt32(&updates, 1)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `{"floatingip": {"id": "fip_1", "floating_ip_address": "192.0.2.10"}}`)
+	})
+
+	th.Mux.HandleFunc("/floatingips/fip_2", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("floating IP fip_2 of another instance was updated")
+	})
+
+	client := thclient.ServiceClient()
+	portIDs := []string{"port_1"}
+
+	assert.NoError(t, computeV2InstanceDisassociateFloatingIP(client, "192.0.2.10", portIDs))
+	assert.NoError(t, computeV2InstanceDisassociateFloatingIP(client, "192.0.2.11", portIDs))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&updates))
+}
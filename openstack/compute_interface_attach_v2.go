@@ -11,21 +11,60 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 )
 
+// computeInterfaceAttachV2AttachFunc waits until the port shows up in the
+// instance's interface list with the requested fixed IP. The attachment itself
+// can be returned by the API before the instance is able to use it.
 func computeInterfaceAttachV2AttachFunc(
-	computeClient *gophercloud.ServiceClient, instanceID, attachmentID string) resource.StateRefreshFunc {
+	computeClient *gophercloud.ServiceClient, instanceID, portID, fixedIP string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		va, err := attachinterfaces.Get(computeClient, instanceID, attachmentID).Extract()
+		allPages, err := attachinterfaces.List(computeClient, instanceID).AllPages()
 		if err != nil {
 			if _, ok := err.(gophercloud.ErrDefault404); ok {
-				return va, "ATTACHING", nil
+				return nil, "ATTACHING", nil
 			}
-			return va, "", err
+			return nil, "", err
+		}
+
+		allInterfaces, err := attachinterfaces.ExtractInterfaces(allPages)
+		if err != nil {
+			return nil, "", err
+		}
+
+		va, ok := computeInterfaceAttachV2FindInterface(allInterfaces, portID, fixedIP)
+		if !ok {
+			log.Printf("[DEBUG] openstack_compute_interface_attach_v2 port %s is not yet present on instance %s", portID, instanceID)
+			return va, "ATTACHING", nil
 		}
 
 		return va, "ATTACHED", nil
 	}
 }
 
+// computeInterfaceAttachV2FindInterface looks up portID in a list of instance
+// interfaces. When fixedIP is set, the interface only matches once it carries
+// that address.
+func computeInterfaceAttachV2FindInterface(interfaces []attachinterfaces.Interface, portID, fixedIP string) (*attachinterfaces.Interface, bool) {
+	for i, v := range interfaces {
+		if v.PortID != portID {
+			continue
+		}
+
+		if fixedIP == "" {
+			return &interfaces[i], true
+		}
+
+		for _, ip := range v.FixedIPs {
+			if ip.IPAddress == fixedIP {
+				return &interfaces[i], true
+			}
+		}
+
+		return &interfaces[i], false
+	}
+
+	return nil, false
+}
+
 func computeInterfaceAttachV2DetachFunc(
 	computeClient *gophercloud.ServiceClient, instanceID, attachmentID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
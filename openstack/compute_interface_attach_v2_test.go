@@ -3,6 +3,7 @@ package openstack
 import (
 	"testing"
 
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/attachinterfaces"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -21,3 +22,31 @@ func TestComputeInterfaceAttachV2ParseID(t *testing.T) {
 	assert.Equal(t, expectedInstanceID, actualInstanceID)
 	assert.Equal(t, expectedAttachmentID, actualAttachmentID)
 }
+
+func TestComputeInterfaceAttachV2FindInterface(t *testing.T) {
+	interfaces := []attachinterfaces.Interface{
+		{
+			PortID: "port_1",
+			FixedIPs: []attachinterfaces.FixedIP{
+				{IPAddress: "192.168.1.10"},
+			},
+		},
+		{
+			PortID: "port_2",
+		},
+	}
+
+	found, ok := computeInterfaceAttachV2FindInterface(interfaces, "port_1", "")
+	assert.True(t, ok)
+	assert.Equal(t, "port_1", found.PortID)
+
+	found, ok = computeInterfaceAttachV2FindInterface(interfaces, "port_1", "192.168.1.10")
+	assert.True(t, ok)
+	assert.Equal(t, "port_1", found.PortID)
+
+	_, ok = computeInterfaceAttachV2FindInterface(interfaces, "port_2", "192.168.1.100")
+	assert.False(t, ok)
+
+	_, ok = computeInterfaceAttachV2FindInterface(interfaces, "port_3", "")
+	assert.False(t, ok)
+}
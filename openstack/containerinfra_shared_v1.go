@@ -9,6 +9,7 @@ import (
 	"encoding/pem"
 	"fmt"
 	"os"
+	"sort"
 	"strings"
 
 	"github.com/gophercloud/gophercloud"
@@ -25,6 +26,8 @@ import (
 const (
 	rsaPrivateKeyBlockType      = "RSA PRIVATE KEY"
 	certificateRequestBlockType = "CERTIFICATE REQUEST"
+
+	containerInfraV1RotateCAMicroversion = "1.5"
 )
 
 func expandContainerInfraV1LabelsMap(v map[string]interface{}) (map[string]string, error) {
@@ -56,6 +59,28 @@ func expandContainerInfraV1LabelsString(v map[string]interface{}) (string, error
 	return formattedLabels, nil
 }
 
+// expandContainerInfraV1Tags returns the comma separated tags of a cluster
+// template.
+func expandContainerInfraV1Tags(v *schema.Set) string {
+	tags := expandToStringSlice(v.List())
+	sort.Strings(tags)
+
+	return strings.Join(tags, ",")
+}
+
+// flattenContainerInfraV1Tags splits the comma separated tags of a cluster
+// template.
+func flattenContainerInfraV1Tags(v string) []string {
+	tags := []string{}
+	for _, tag := range strings.Split(v, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+
+	return tags
+}
+
 func containerInfraClusterTemplateV1AppendUpdateOpts(updateOpts []clustertemplates.UpdateOptsBuilder, attribute, value string) []clustertemplates.UpdateOptsBuilder {
 	if value == "" {
 		updateOpts = append(updateOpts, clustertemplates.UpdateOpts{
@@ -102,6 +127,14 @@ func containerInfraClusterV1StateRefreshFunc(client *gophercloud.ServiceClient,
 	}
 }
 
+// containerInfraClusterV1RotateCA triggers the rotation of the CA certificate
+// of a cluster.
+func containerInfraClusterV1RotateCA(client *gophercloud.ServiceClient, clusterID string) error {
+	client.Microversion = containerInfraV1RotateCAMicroversion
+
+	return certificates.Update(client, clusterID).ExtractErr()
+}
+
 // containerInfraClusterV1Flavor will determine the flavor for a container infra
 // cluster based on either what was set in the configuration or environment
 // variable.
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/gophercloud/gophercloud/openstack/containerinfra/v1/clustertemplates"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -42,6 +43,18 @@ func TestExpandContainerInfraV1LabelsString(t *testing.T) {
 	}
 }
 
+func TestExpandContainerInfraV1Tags(t *testing.T) {
+	tags := schema.NewSet(schema.HashString, []interface{}{"foo", "bar"})
+
+	assert.Equal(t, "bar,foo", expandContainerInfraV1Tags(tags))
+	assert.Equal(t, "", expandContainerInfraV1Tags(schema.NewSet(schema.HashString, nil)))
+}
+
+func TestFlattenContainerInfraV1Tags(t *testing.T) {
+	assert.Equal(t, []string{"foo", "bar"}, flattenContainerInfraV1Tags("foo, bar"))
+	assert.Equal(t, []string{}, flattenContainerInfraV1Tags(""))
+}
+
 func TestContainerInfraClusterTemplateV1AppendUpdateOpts(t *testing.T) {
 	actualUpdateOpts := []clustertemplates.UpdateOptsBuilder{}
 
@@ -66,3 +79,22 @@ func TestContainerInfraClusterTemplateV1AppendUpdateOpts(t *testing.T) {
 
 	assert.Equal(t, expectedUpdateOpts, actualUpdateOpts)
 }
+
+func TestContainerInfraClusterTemplateCreateOptsExt(t *testing.T) {
+	hidden := true
+	var createOpts clustertemplates.CreateOptsBuilder = ContainerInfraClusterTemplateCreateOptsExt{
+		CreateOptsBuilder: clustertemplates.CreateOpts{
+			Name:    "template_1",
+			COE:     "kubernetes",
+			ImageID: "image_1",
+		},
+		Hidden: &hidden,
+		Tags:   "foo,bar",
+	}
+
+	actual, err := createOpts.ToClusterCreateMap()
+	assert.NoError(t, err)
+	assert.Equal(t, "template_1", actual["name"])
+	assert.Equal(t, true, actual["hidden"])
+	assert.Equal(t, "foo,bar", actual["tags"])
+}
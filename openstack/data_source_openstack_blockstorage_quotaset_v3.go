@@ -0,0 +1,147 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/quotasets"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceBlockStorageQuotasetV3() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBlockStorageQuotasetV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"volumes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"snapshots": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"gigabytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"per_volume_gigabytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"backups": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"backup_gigabytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"groups": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"volume_type_quota": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+
+			"usage": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"in_use": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"allocated": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"reserved": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"limit": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceBlockStorageQuotasetV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	blockStorageClient, err := config.BlockStorageV3Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	projectID := d.Get("project_id").(string)
+
+	q, err := quotasets.Get(blockStorageClient, projectID).Extract()
+	if err != nil {
+		return fmt.Errorf("Error retrieving openstack_blockstorage_quotaset_v3 %s: %s", projectID, err)
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_blockstorage_quotaset_v3 %s: %#v", projectID, q)
+
+	// The raw usage is extracted, so that the per volume type usage is kept.
+	var usage struct {
+		QuotaSet map[string]interface{} `json:"quota_set"`
+	}
+	err = quotasets.GetUsage(blockStorageClient, projectID).ExtractInto(&usage)
+	if err != nil {
+		return fmt.Errorf("Error retrieving openstack_blockstorage_quotaset_v3 %s usage: %s", projectID, err)
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_blockstorage_quotaset_v3 %s usage: %#v", projectID, usage.QuotaSet)
+
+	d.SetId(fmt.Sprintf("%s/%s", projectID, region))
+	d.Set("project_id", projectID)
+	d.Set("region", region)
+	d.Set("volumes", q.Volumes)
+	d.Set("snapshots", q.Snapshots)
+	d.Set("gigabytes", q.Gigabytes)
+	d.Set("per_volume_gigabytes", q.PerVolumeGigabytes)
+	d.Set("backups", q.Backups)
+	d.Set("backup_gigabytes", q.BackupGigabytes)
+	d.Set("groups", q.Groups)
+
+	if err := d.Set("volume_type_quota", flattenBlockStorageVolumeTypeQuota(q.Extra)); err != nil {
+		log.Printf("[DEBUG] Unable to set openstack_blockstorage_quotaset_v3 %s volume_type_quota: %s", d.Id(), err)
+	}
+
+	if err := d.Set("usage", flattenBlockStorageQuotasetUsage(usage.QuotaSet)); err != nil {
+		log.Printf("[DEBUG] Unable to set openstack_blockstorage_quotaset_v3 %s usage: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccBlockStorageV3QuotasetDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageV3QuotasetDataSourceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_blockstorage_quotaset_v3.quotaset_1", "project_id",
+						"openstack_identity_project_v3.project_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_blockstorage_quotaset_v3.quotaset_1", "gigabytes", "2"),
+					resource.TestCheckOutput("gigabytes_in_use", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBlockStorageV3QuotasetDataSourceBasic() string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_blockstorage_quotaset_v3" "quotaset_1" {
+  project_id = "${openstack_blockstorage_quotaset_v3.quotaset_1.project_id}"
+}
+
+output "gigabytes_in_use" {
+  value = [for u in data.openstack_blockstorage_quotaset_v3.quotaset_1.usage : u.in_use if u.resource == "gigabytes"][0]
+}
+`, testAccBlockStorageQuotasetV3Basic)
+}
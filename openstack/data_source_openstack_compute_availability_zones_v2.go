@@ -2,6 +2,7 @@ package openstack
 
 import (
 	"fmt"
+	"log"
 	"sort"
 
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/availabilityzones"
@@ -34,6 +35,61 @@ func dataSourceComputeAvailabilityZonesV2() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringInSlice([]string{"available", "unavailable"}, true),
 			},
+
+			"detail": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"zones": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"available": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"hosts": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"services": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"name": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"active": {
+													Type:     schema.TypeBool,
+													Computed: true,
+												},
+												"available": {
+													Type:     schema.TypeBool,
+													Computed: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -46,7 +102,13 @@ func dataSourceComputeAvailabilityZonesV2Read(d *schema.ResourceData, meta inter
 		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
 	}
 
-	allPages, err := availabilityzones.List(computeClient).AllPages()
+	detail := d.Get("detail").(bool)
+	pager := availabilityzones.List(computeClient)
+	if detail {
+		pager = availabilityzones.ListDetail(computeClient)
+	}
+
+	allPages, err := pager.AllPages()
 	if err != nil {
 		return fmt.Errorf("Error retrieving openstack_compute_availability_zones_v2: %s", err)
 	}
@@ -57,9 +119,11 @@ func dataSourceComputeAvailabilityZonesV2Read(d *schema.ResourceData, meta inter
 
 	stateBool := d.Get("state").(string) == "available"
 	zones := make([]string, 0, len(zoneInfo))
+	matchingZones := make([]availabilityzones.AvailabilityZone, 0, len(zoneInfo))
 	for _, z := range zoneInfo {
 		if z.ZoneState.Available == stateBool {
 			zones = append(zones, z.ZoneName)
+			matchingZones = append(matchingZones, z)
 		}
 	}
 
@@ -70,5 +134,13 @@ func dataSourceComputeAvailabilityZonesV2Read(d *schema.ResourceData, meta inter
 	d.Set("names", zones)
 	d.Set("region", region)
 
+	var zonesDetail []map[string]interface{}
+	if detail {
+		zonesDetail = flattenComputeAvailabilityZonesV2Detail(matchingZones)
+	}
+	if err := d.Set("zones", zonesDetail); err != nil {
+		log.Printf("[DEBUG] Unable to set openstack_compute_availability_zones_v2 zones: %s", err)
+	}
+
 	return nil
 }
@@ -25,6 +25,32 @@ func TestAccOpenStackAvailabilityZonesV2_basic(t *testing.T) {
 	})
 }
 
+func TestAccOpenStackAvailabilityZonesV2_detail(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpenStackAvailabilityZonesConfigDetail,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.openstack_compute_availability_zones_v2.zones", "names.#", regexp.MustCompile("[1-9]\\d*")),
+					resource.TestMatchResourceAttr("data.openstack_compute_availability_zones_v2.zones", "zones.#", regexp.MustCompile("[1-9]\\d*")),
+					resource.TestMatchResourceAttr("data.openstack_compute_availability_zones_v2.zones", "zones.0.hosts.#", regexp.MustCompile("[1-9]\\d*")),
+				),
+			},
+		},
+	})
+}
+
 const testAccOpenStackAvailabilityZonesConfig = `
 data "openstack_compute_availability_zones_v2" "zones" {}
 `
+
+const testAccOpenStackAvailabilityZonesConfigDetail = `
+data "openstack_compute_availability_zones_v2" "zones" {
+  detail = true
+}
+`
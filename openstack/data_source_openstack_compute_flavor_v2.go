@@ -26,7 +26,7 @@ func dataSourceComputeFlavorV2() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"name", "min_ram", "min_disk"},
+				ConflictsWith: []string{"name", "min_ram", "min_disk", "min_vcpus", "smallest"},
 			},
 
 			"name": {
@@ -55,6 +55,21 @@ func dataSourceComputeFlavorV2() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"min_vcpus": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"flavor_id"},
+			},
+
+			"smallest": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ForceNew:      true,
+				Default:       false,
+				ConflictsWith: []string{"flavor_id"},
+			},
+
 			"min_disk": {
 				Type:          schema.TypeInt,
 				Optional:      true,
@@ -167,6 +182,12 @@ func dataSourceComputeFlavorV2Read(d *schema.ResourceData, meta interface{}) err
 				}
 			}
 
+			if v, ok := d.GetOk("min_vcpus"); ok {
+				if flavor.VCPUs < v.(int) {
+					continue
+				}
+			}
+
 			if v, ok := d.GetOk("disk"); ok {
 				if flavor.Disk != v.(int) {
 					continue
@@ -196,13 +217,19 @@ func dataSourceComputeFlavorV2Read(d *schema.ResourceData, meta interface{}) err
 			"Please change your search criteria and try again.")
 	}
 
+	flavor := allFlavors[0]
 	if len(allFlavors) > 1 {
-		log.Printf("[DEBUG] Multiple results found: %#v", allFlavors)
-		return fmt.Errorf("Your query returned more than one result. " +
-			"Please try a more specific search criteria")
+		smallest := d.Get("smallest").(bool)
+		log.Printf("[DEBUG] Multiple results found and `smallest` is set to: %t", smallest)
+		if !smallest {
+			log.Printf("[DEBUG] Multiple results found: %#v", allFlavors)
+			return fmt.Errorf("Your query returned more than one result. " +
+				"Please try a more specific search criteria, or set `smallest` attribute to true.")
+		}
+		flavor = computeFlavorV2Smallest(allFlavors)
 	}
 
-	return dataSourceComputeFlavorV2Attributes(d, computeClient, &allFlavors[0])
+	return dataSourceComputeFlavorV2Attributes(d, computeClient, &flavor)
 }
 
 // dataSourceComputeFlavorV2Attributes populates the fields of a Flavor resource.
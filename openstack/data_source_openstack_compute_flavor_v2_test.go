@@ -185,6 +185,33 @@ func TestAccComputeV2FlavorDataSource_flavorID(t *testing.T) {
 	})
 }
 
+func TestAccComputeV2FlavorDataSource_smallest(t *testing.T) {
+	var flavorName = acctest.RandomWithPrefix("tf-acc-flavor")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeV2FlavorDataSourceSmallest(flavorName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2FlavorDataSourceID("data.openstack_compute_flavor_v2.flavor_1"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_compute_flavor_v2.flavor_1", "id",
+						"openstack_compute_flavor_v2.flavor_3", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_compute_flavor_v2.flavor_1", "vcpus", "97"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_compute_flavor_v2.flavor_1", "ram", "1024"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckComputeV2FlavorDataSourceID(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -256,3 +283,44 @@ func testAccComputeV2FlavorDataSourceFlavorID(flavorName string) string {
           }
           `, flavorResource)
 }
+
+func testAccComputeV2FlavorDataSourceSmallest(flavorName string) string {
+	return fmt.Sprintf(`
+resource "openstack_compute_flavor_v2" "flavor_1" {
+  name      = "%s-1"
+  vcpus     = 98
+  ram       = 512
+  disk      = 5
+  is_public = false
+}
+
+resource "openstack_compute_flavor_v2" "flavor_2" {
+  name      = "%s-2"
+  vcpus     = 97
+  ram       = 2048
+  disk      = 5
+  is_public = false
+}
+
+resource "openstack_compute_flavor_v2" "flavor_3" {
+  name      = "%s-3"
+  vcpus     = 97
+  ram       = 1024
+  disk      = 10
+  is_public = false
+}
+
+data "openstack_compute_flavor_v2" "flavor_1" {
+  min_vcpus = 97
+  min_ram   = 1024
+  is_public = false
+  smallest  = true
+
+  depends_on = [
+    "openstack_compute_flavor_v2.flavor_1",
+    "openstack_compute_flavor_v2.flavor_2",
+    "openstack_compute_flavor_v2.flavor_3",
+  ]
+}
+`, flavorName, flavorName, flavorName)
+}
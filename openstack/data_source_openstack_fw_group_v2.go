@@ -0,0 +1,127 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/fwaas_v2/groups"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceFWGroupV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceFWGroupV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"ingress_firewall_policy_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"egress_firewall_policy_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"admin_state_up": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"ports": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"shared": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceFWGroupV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	listOpts := groups.ListOpts{
+		ID:       d.Get("group_id").(string),
+		Name:     d.Get("name").(string),
+		TenantID: d.Get("tenant_id").(string),
+	}
+
+	pages, err := groups.List(networkingClient, listOpts).AllPages()
+	if err != nil {
+		return err
+	}
+
+	allFWGroups, err := groups.ExtractGroups(pages)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve openstack_fw_group_v2: %s", err)
+	}
+
+	if len(allFWGroups) < 1 {
+		return fmt.Errorf("No openstack_fw_group_v2 found with name: %s", d.Get("name"))
+	}
+
+	if len(allFWGroups) > 1 {
+		return fmt.Errorf("More than one openstack_fw_group_v2 found with name: %s", d.Get("name"))
+	}
+
+	group := allFWGroups[0]
+
+	log.Printf("[DEBUG] Retrieved openstack_fw_group_v2 %s: %#v", group.ID, group)
+	d.SetId(group.ID)
+
+	d.Set("name", group.Name)
+	d.Set("tenant_id", group.TenantID)
+	d.Set("description", group.Description)
+	d.Set("ingress_firewall_policy_id", group.IngressFirewallPolicyID)
+	d.Set("egress_firewall_policy_id", group.EgressFirewallPolicyID)
+	d.Set("admin_state_up", group.AdminStateUp)
+	d.Set("ports", group.Ports)
+	d.Set("status", group.Status)
+	d.Set("shared", group.Shared)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
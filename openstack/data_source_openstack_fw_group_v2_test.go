@@ -0,0 +1,131 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/fwaas_v2/groups"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/fwaas_v2/policies"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/fwaas_v2/rules"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+// testAccFWV2Objects holds the FWaaS v2 objects created for the data source
+// tests, since the provider doesn't manage them.
+type testAccFWV2Objects struct {
+	ruleID   string
+	policyID string
+	groupID  string
+}
+
+func TestAccOpenStackNetworkingFWGroupV2DataSource_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-%s", acctest.RandString(5))
+	var objects testAccFWV2Objects
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckFWV2(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFWV2ObjectsDestroy(&objects),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					if err := testAccFWV2ObjectsCreate(name, &objects); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccOpenStackNetworkingFWGroupV2DataSourceBasic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr(
+						"data.openstack_fw_group_v2.group_1", "id", &objects.groupID),
+					resource.TestCheckResourceAttr(
+						"data.openstack_fw_group_v2.group_1", "name", name),
+					resource.TestCheckResourceAttrPtr(
+						"data.openstack_fw_group_v2.group_1", "ingress_firewall_policy_id", &objects.policyID),
+				),
+			},
+		},
+	})
+}
+
+func testAccFWV2ObjectsCreate(name string, objects *testAccFWV2Objects) error {
+	config := testAccProvider.Meta().(*Config)
+	networkingClient, err := config.NetworkingV2Client(osRegionName)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	rule, err := rules.Create(networkingClient, rules.CreateOpts{
+		Name:            name,
+		Protocol:        rules.ProtocolTCP,
+		Action:          rules.ActionAllow,
+		DestinationPort: "22",
+	}).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating FWaaS v2 rule %s: %s", name, err)
+	}
+	objects.ruleID = rule.ID
+
+	policy, err := policies.Create(networkingClient, policies.CreateOpts{
+		Name:          name,
+		FirewallRules: []string{rule.ID},
+	}).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating FWaaS v2 policy %s: %s", name, err)
+	}
+	objects.policyID = policy.ID
+
+	group, err := groups.Create(networkingClient, groups.CreateOpts{
+		Name:                    name,
+		IngressFirewallPolicyID: policy.ID,
+	}).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating FWaaS v2 group %s: %s", name, err)
+	}
+	objects.groupID = group.ID
+
+	return nil
+}
+
+func testAccCheckFWV2ObjectsDestroy(objects *testAccFWV2Objects) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.NetworkingV2Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+		}
+
+		if objects.groupID != "" {
+			if err := groups.Delete(networkingClient, objects.groupID).ExtractErr(); err != nil {
+				return fmt.Errorf("Error deleting FWaaS v2 group %s: %s", objects.groupID, err)
+			}
+		}
+
+		if objects.policyID != "" {
+			if err := policies.Delete(networkingClient, objects.policyID).ExtractErr(); err != nil {
+				return fmt.Errorf("Error deleting FWaaS v2 policy %s: %s", objects.policyID, err)
+			}
+		}
+
+		if objects.ruleID != "" {
+			if err := rules.Delete(networkingClient, objects.ruleID).ExtractErr(); err != nil {
+				return fmt.Errorf("Error deleting FWaaS v2 rule %s: %s", objects.ruleID, err)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccOpenStackNetworkingFWGroupV2DataSourceBasic(name string) string {
+	return fmt.Sprintf(`
+data "openstack_fw_group_v2" "group_1" {
+  name = "%s"
+}
+`, name)
+}
@@ -0,0 +1,144 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/fwaas_v2/rules"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceFWRuleV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceFWRuleV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"rule_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"protocol": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"action": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"ip_version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"source_ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"source_port": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"destination_ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"destination_port": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"shared": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceFWRuleV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	listOpts := rules.ListOpts{
+		ID:       d.Get("rule_id").(string),
+		Name:     d.Get("name").(string),
+		TenantID: d.Get("tenant_id").(string),
+	}
+
+	pages, err := rules.List(networkingClient, listOpts).AllPages()
+	if err != nil {
+		return err
+	}
+
+	allFWRules, err := rules.ExtractRules(pages)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve openstack_fw_rule_v2: %s", err)
+	}
+
+	if len(allFWRules) < 1 {
+		return fmt.Errorf("No openstack_fw_rule_v2 found with name: %s", d.Get("name"))
+	}
+
+	if len(allFWRules) > 1 {
+		return fmt.Errorf("More than one openstack_fw_rule_v2 found with name: %s", d.Get("name"))
+	}
+
+	rule := allFWRules[0]
+
+	log.Printf("[DEBUG] Retrieved openstack_fw_rule_v2 %s: %#v", rule.ID, rule)
+	d.SetId(rule.ID)
+
+	d.Set("name", rule.Name)
+	d.Set("tenant_id", rule.TenantID)
+	d.Set("description", rule.Description)
+	d.Set("protocol", rule.Protocol)
+	d.Set("action", rule.Action)
+	d.Set("ip_version", rule.IPVersion)
+	d.Set("source_ip_address", rule.SourceIPAddress)
+	d.Set("source_port", rule.SourcePort)
+	d.Set("destination_ip_address", rule.DestinationIPAddress)
+	d.Set("destination_port", rule.DestinationPort)
+	d.Set("shared", rule.Shared)
+	d.Set("enabled", rule.Enabled)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccOpenStackNetworkingFWRuleV2DataSource_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-%s", acctest.RandString(5))
+	var objects testAccFWV2Objects
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckFWV2(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFWV2ObjectsDestroy(&objects),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					if err := testAccFWV2ObjectsCreate(name, &objects); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccOpenStackNetworkingFWRuleV2DataSourceBasic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr(
+						"data.openstack_fw_rule_v2.rule_1", "id", &objects.ruleID),
+					resource.TestCheckResourceAttr(
+						"data.openstack_fw_rule_v2.rule_1", "protocol", "tcp"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_fw_rule_v2.rule_1", "action", "allow"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_fw_rule_v2.rule_1", "destination_port", "22"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOpenStackNetworkingFWRuleV2DataSourceBasic(name string) string {
+	return fmt.Sprintf(`
+data "openstack_fw_rule_v2" "rule_1" {
+  name = "%s"
+}
+`, name)
+}
@@ -28,6 +28,12 @@ func TestAccOpenStackIdentityAuthScopeV3DataSource_basic(t *testing.T) {
 						"data.openstack_identity_auth_scope_v3.token", "user_name", userName),
 					resource.TestCheckResourceAttr(
 						"data.openstack_identity_auth_scope_v3.token", "project_name", projectName),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_identity_auth_scope_v3.token", "project_id"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_identity_auth_scope_v3.token", "user_id"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_identity_auth_scope_v3.token", "service_catalog.#"),
 				),
 			},
 		},
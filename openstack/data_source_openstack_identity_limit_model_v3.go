@@ -0,0 +1,59 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceIdentityLimitModelV3() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIdentityLimitModelV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceIdentityLimitModelV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	identityClient, err := config.IdentityV3Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	model, err := identityLimitModelV3Get(identityClient)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve openstack_identity_limit_model_v3: %s", err)
+	}
+
+	if model == nil {
+		return fmt.Errorf("Unable to retrieve openstack_identity_limit_model_v3: empty response")
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_identity_limit_model_v3: %#v", model)
+
+	d.SetId(fmt.Sprintf("%s/%s", region, model.Name))
+	d.Set("name", model.Name)
+	d.Set("description", model.Description)
+	d.Set("region", region)
+
+	return nil
+}
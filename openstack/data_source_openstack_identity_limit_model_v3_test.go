@@ -0,0 +1,31 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccOpenStackIdentityV3LimitModelDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpenStackIdentityLimitModelV3DataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_identity_limit_model_v3.model", "name"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_identity_limit_model_v3.model", "description"),
+				),
+			},
+		},
+	})
+}
+
+const testAccOpenStackIdentityLimitModelV3DataSourceBasic = `
+data "openstack_identity_limit_model_v3" "model" {}
+`
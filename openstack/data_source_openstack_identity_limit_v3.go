@@ -0,0 +1,105 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceIdentityLimitV3() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIdentityLimitV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"service_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"resource_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"region_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"resource_limit": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"domain_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceIdentityLimitV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	identityClient, err := config.IdentityV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	listOpts := identityLimitV3ListOpts{
+		ProjectID:    d.Get("project_id").(string),
+		ServiceID:    d.Get("service_id").(string),
+		RegionID:     d.Get("region_id").(string),
+		ResourceName: d.Get("resource_name").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_identity_limit_v3 list options: %#v", listOpts)
+
+	allLimits, err := identityLimitV3List(identityClient, listOpts)
+	if err != nil {
+		return fmt.Errorf("Unable to query openstack_identity_limit_v3: %s", err)
+	}
+
+	if len(allLimits) < 1 {
+		return fmt.Errorf("Your openstack_identity_limit_v3 query returned no results. " +
+			"Please change your search criteria and try again")
+	}
+
+	if len(allLimits) > 1 {
+		return fmt.Errorf("Your openstack_identity_limit_v3 query returned more than one result")
+	}
+
+	limit := allLimits[0]
+
+	log.Printf("[DEBUG] Retrieved openstack_identity_limit_v3 %s: %#v", limit.ID, limit)
+
+	d.SetId(limit.ID)
+	d.Set("project_id", limit.ProjectID)
+	d.Set("service_id", limit.ServiceID)
+	d.Set("resource_name", limit.ResourceName)
+	d.Set("region_id", limit.RegionID)
+	d.Set("resource_limit", limit.ResourceLimit)
+	d.Set("description", limit.Description)
+	d.Set("domain_id", limit.DomainID)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
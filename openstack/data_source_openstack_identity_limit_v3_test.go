@@ -0,0 +1,181 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccOpenStackIdentityV3LimitDataSource_basic(t *testing.T) {
+	projectName := fmt.Sprintf("tf-acc-%s", acctest.RandString(5))
+	resourceName := fmt.Sprintf("tf_acc_%s", acctest.RandString(5))
+	var registeredLimitID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIdentityV3RegisteredLimitDestroy(&registeredLimitID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpenStackIdentityLimitV3DataSourceProject(projectName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3LimitCreate(
+						"openstack_identity_project_v3.project_1",
+						"data.openstack_identity_service_v3.service_1",
+						resourceName, &registeredLimitID),
+				),
+			},
+			{
+				Config: testAccOpenStackIdentityLimitV3DataSourceBasic(projectName, resourceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_identity_limit_v3.limit_1", "project_id",
+						"openstack_identity_project_v3.project_1", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_identity_limit_v3.limit_1", "service_id",
+						"data.openstack_identity_service_v3.service_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_identity_limit_v3.limit_1", "resource_name", resourceName),
+					resource.TestCheckResourceAttr(
+						"data.openstack_identity_limit_v3.limit_1", "resource_limit", "5"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_identity_limit_v3.limit_1", "description", "terraform acceptance test"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckIdentityV3LimitCreate creates a registered limit and a project
+// limit, since the provider doesn't manage them.
+func testAccCheckIdentityV3LimitCreate(project, service, resourceName string, registeredLimitID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+		identityClient, err := config.IdentityV3Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+		}
+
+		p, ok := s.RootModule().Resources[project]
+		if !ok {
+			return fmt.Errorf("Not found: %s", project)
+		}
+
+		svc, ok := s.RootModule().Resources[service]
+		if !ok {
+			return fmt.Errorf("Not found: %s", service)
+		}
+
+		*registeredLimitID, err = testAccIdentityV3RegisteredLimitCreate(identityClient, svc.Primary.ID, resourceName, 10)
+		if err != nil {
+			return err
+		}
+
+		limits := map[string]interface{}{
+			"limits": []map[string]interface{}{
+				{
+					"project_id":     p.Primary.ID,
+					"service_id":     svc.Primary.ID,
+					"resource_name":  resourceName,
+					"resource_limit": 5,
+					"description":    "terraform acceptance test",
+				},
+			},
+		}
+
+		_, err = identityClient.Post(identityClient.ServiceURL("limits"), limits, nil, &gophercloud.RequestOpts{
+			OkCodes: []int{201},
+		})
+		if err != nil {
+			return fmt.Errorf("Error creating limit: %s", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccIdentityV3RegisteredLimitCreate(identityClient *gophercloud.ServiceClient, serviceID, resourceName string, defaultLimit int) (string, error) {
+	registeredLimits := map[string]interface{}{
+		"registered_limits": []map[string]interface{}{
+			{
+				"service_id":    serviceID,
+				"resource_name": resourceName,
+				"default_limit": defaultLimit,
+			},
+		},
+	}
+
+	var r gophercloud.Result
+	_, r.Err = identityClient.Post(identityClient.ServiceURL("registered_limits"), registeredLimits, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+
+	var s struct {
+		RegisteredLimits []struct {
+			ID string `json:"id"`
+		} `json:"registered_limits"`
+	}
+	if err := r.ExtractInto(&s); err != nil {
+		return "", fmt.Errorf("Error creating registered limit: %s", err)
+	}
+
+	if len(s.RegisteredLimits) < 1 {
+		return "", fmt.Errorf("Error creating registered limit: empty response")
+	}
+
+	return s.RegisteredLimits[0].ID, nil
+}
+
+// testAccCheckIdentityV3RegisteredLimitDestroy removes the registered limit.
+// The project limit is removed by Keystone together with the project.
+func testAccCheckIdentityV3RegisteredLimitDestroy(registeredLimitID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if *registeredLimitID == "" {
+			return nil
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		identityClient, err := config.IdentityV3Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+		}
+
+		_, err = identityClient.Delete(identityClient.ServiceURL("registered_limits", *registeredLimitID), nil)
+		if err != nil {
+			return fmt.Errorf("Error deleting registered limit %s: %s", *registeredLimitID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccOpenStackIdentityLimitV3DataSourceProject(projectName string) string {
+	return fmt.Sprintf(`
+resource "openstack_identity_project_v3" "project_1" {
+  name = "%s"
+}
+
+data "openstack_identity_service_v3" "service_1" {
+  name = "keystone"
+}
+`, projectName)
+}
+
+func testAccOpenStackIdentityLimitV3DataSourceBasic(projectName, resourceName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_identity_limit_v3" "limit_1" {
+  project_id    = "${openstack_identity_project_v3.project_1.id}"
+  service_id    = "${data.openstack_identity_service_v3.service_1.id}"
+  resource_name = "%s"
+}
+`, testAccOpenStackIdentityLimitV3DataSourceProject(projectName), resourceName)
+}
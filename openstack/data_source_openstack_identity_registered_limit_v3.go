@@ -0,0 +1,93 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceIdentityRegisteredLimitV3() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIdentityRegisteredLimitV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"service_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"resource_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"region_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"default_limit": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceIdentityRegisteredLimitV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	identityClient, err := config.IdentityV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	listOpts := identityRegisteredLimitV3ListOpts{
+		ServiceID:    d.Get("service_id").(string),
+		RegionID:     d.Get("region_id").(string),
+		ResourceName: d.Get("resource_name").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_identity_registered_limit_v3 list options: %#v", listOpts)
+
+	allRegisteredLimits, err := identityRegisteredLimitV3List(identityClient, listOpts)
+	if err != nil {
+		return fmt.Errorf("Unable to query openstack_identity_registered_limit_v3: %s", err)
+	}
+
+	if len(allRegisteredLimits) < 1 {
+		return fmt.Errorf("Your openstack_identity_registered_limit_v3 query returned no results. " +
+			"Please change your search criteria and try again")
+	}
+
+	if len(allRegisteredLimits) > 1 {
+		return fmt.Errorf("Your openstack_identity_registered_limit_v3 query returned more than one result. " +
+			"Please specify region_id")
+	}
+
+	registeredLimit := allRegisteredLimits[0]
+
+	log.Printf("[DEBUG] Retrieved openstack_identity_registered_limit_v3 %s: %#v", registeredLimit.ID, registeredLimit)
+
+	d.SetId(registeredLimit.ID)
+	d.Set("service_id", registeredLimit.ServiceID)
+	d.Set("resource_name", registeredLimit.ResourceName)
+	d.Set("region_id", registeredLimit.RegionID)
+	d.Set("default_limit", registeredLimit.DefaultLimit)
+	d.Set("description", registeredLimit.Description)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
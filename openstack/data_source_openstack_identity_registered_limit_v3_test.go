@@ -0,0 +1,81 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccOpenStackIdentityV3RegisteredLimitDataSource_basic(t *testing.T) {
+	resourceName := fmt.Sprintf("tf_acc_%s", acctest.RandString(5))
+	var registeredLimitID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIdentityV3RegisteredLimitDestroy(&registeredLimitID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpenStackIdentityRegisteredLimitV3DataSourceService,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3RegisteredLimitCreate(
+						"data.openstack_identity_service_v3.service_1", resourceName, &registeredLimitID),
+				),
+			},
+			{
+				Config: testAccOpenStackIdentityRegisteredLimitV3DataSourceBasic(resourceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_identity_registered_limit_v3.registered_limit_1", "service_id",
+						"data.openstack_identity_service_v3.service_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_identity_registered_limit_v3.registered_limit_1", "resource_name", resourceName),
+					resource.TestCheckResourceAttr(
+						"data.openstack_identity_registered_limit_v3.registered_limit_1", "default_limit", "10"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIdentityV3RegisteredLimitCreate(service, resourceName string, registeredLimitID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+		identityClient, err := config.IdentityV3Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+		}
+
+		svc, ok := s.RootModule().Resources[service]
+		if !ok {
+			return fmt.Errorf("Not found: %s", service)
+		}
+
+		*registeredLimitID, err = testAccIdentityV3RegisteredLimitCreate(identityClient, svc.Primary.ID, resourceName, 10)
+
+		return err
+	}
+}
+
+const testAccOpenStackIdentityRegisteredLimitV3DataSourceService = `
+data "openstack_identity_service_v3" "service_1" {
+  name = "keystone"
+}
+`
+
+func testAccOpenStackIdentityRegisteredLimitV3DataSourceBasic(resourceName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_identity_registered_limit_v3" "registered_limit_1" {
+  service_id    = "${data.openstack_identity_service_v3.service_1.id}"
+  resource_name = "%s"
+}
+`, testAccOpenStackIdentityRegisteredLimitV3DataSourceService, resourceName)
+}
@@ -177,16 +177,11 @@ func dataSourceImagesImageIdsV2Read(d *schema.ResourceData, meta interface{}) er
 
 	log.Printf("[DEBUG] List Options in openstack_images_image_ids_v2: %#v", listOpts)
 
-	allPages, err := images.List(imageClient, listOpts).AllPages()
+	allImages, err := config.imageListCache.List(imageClient, listOpts)
 	if err != nil {
 		return fmt.Errorf("Unable to list images in openstack_images_image_ids_v2: %s", err)
 	}
 
-	allImages, err := images.ExtractImages(allPages)
-	if err != nil {
-		return fmt.Errorf("Unable to retrieve images in openstack_images_image_ids_v2: %s", err)
-	}
-
 	log.Printf("[DEBUG] Retrieved %d images in openstack_images_image_ids_v2: %+v", len(allImages), allImages)
 
 	allImages = imagesFilterByProperties(allImages, properties)
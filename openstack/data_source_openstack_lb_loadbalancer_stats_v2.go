@@ -0,0 +1,80 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/loadbalancers"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceLBLoadBalancerStatsV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceLBLoadBalancerStatsV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"loadbalancer_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"active_connections": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"bytes_in": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"bytes_out": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"request_errors": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"total_connections": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceLBLoadBalancerStatsV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	lbClient, err := config.LoadBalancerV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack load balancing client: %s", err)
+	}
+
+	lbID := d.Get("loadbalancer_id").(string)
+
+	stats, err := loadbalancers.GetStats(lbClient, lbID).Extract()
+	if err != nil {
+		return fmt.Errorf("Error retrieving openstack_lb_loadbalancer_stats_v2 %s: %s", lbID, err)
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_lb_loadbalancer_stats_v2 %s: %#v", lbID, stats)
+
+	d.SetId(lbID)
+	d.Set("region", GetRegion(d, config))
+	d.Set("active_connections", stats.ActiveConnections)
+	d.Set("bytes_in", stats.BytesIn)
+	d.Set("bytes_out", stats.BytesOut)
+	d.Set("request_errors", stats.RequestErrors)
+	d.Set("total_connections", stats.TotalConnections)
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccLBV2LoadBalancerStatsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckLB(t)
+			testAccPreCheckUseOctavia(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBV2LoadBalancerStatsDataSourceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_lb_loadbalancer_stats_v2.stats_1", "id",
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "id"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_lb_loadbalancer_stats_v2.stats_1", "active_connections"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_lb_loadbalancer_stats_v2.stats_1", "bytes_in"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_lb_loadbalancer_stats_v2.stats_1", "bytes_out"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLBV2LoadBalancerStatsDataSourceBasic() string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_lb_loadbalancer_stats_v2" "stats_1" {
+  loadbalancer_id = "${openstack_lb_loadbalancer_v2.loadbalancer_1.id}"
+}
+`, testAccLbV2LoadBalancerConfigBasic("octavia"))
+}
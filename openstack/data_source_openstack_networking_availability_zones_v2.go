@@ -0,0 +1,76 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func dataSourceNetworkingAvailabilityZonesV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNetworkingAvailabilityZonesV2Read,
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Optional: true,
+			},
+
+			"state": {
+				Type:         schema.TypeString,
+				Default:      "available",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"available", "unavailable"}, true),
+			},
+
+			"resource": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"network", "router"}, false),
+			},
+
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetworkingAvailabilityZonesV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	networkingClient, err := config.NetworkingV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	available, err := networkingAvailabilityZonesV2Available(networkingClient)
+	if err != nil {
+		return err
+	}
+
+	var zoneInfo []networkingAvailabilityZoneV2
+	if available {
+		zoneInfo, err = networkingAvailabilityZonesV2List(networkingClient)
+		if err != nil {
+			return fmt.Errorf("Error retrieving openstack_networking_availability_zones_v2: %s", err)
+		}
+	} else {
+		log.Printf("[WARN] The Neutron availability_zone extension is not available, openstack_networking_availability_zones_v2 is empty")
+	}
+
+	zones := filterNetworkingAvailabilityZonesV2(zoneInfo, d.Get("state").(string), d.Get("resource").(string))
+
+	d.SetId(hashcode.Strings(zones))
+	d.Set("names", zones)
+	d.Set("region", region)
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package openstack
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccNetworkingV2AvailabilityZonesV2_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckNetworkingAvailabilityZones(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2AvailabilityZonesV2Config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.openstack_networking_availability_zones_v2.zones", "names.#", regexp.MustCompile("[1-9]\\d*")),
+				),
+			},
+		},
+	})
+}
+
+const testAccNetworkingV2AvailabilityZonesV2Config = `
+data "openstack_networking_availability_zones_v2" "zones" {}
+`
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/external"
@@ -66,7 +67,11 @@ func dataSourceNetworkingNetworkV2() *schema.Resource {
 
 			"shared": {
 				Type:     schema.TypeString,
+				Optional: true,
 				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"true", "false",
+				}, false),
 			},
 
 			"external": {
@@ -123,52 +128,7 @@ func dataSourceNetworkingNetworkV2Read(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
-	// Prepare basic listOpts.
-	var listOpts networks.ListOptsBuilder
-
-	var status string
-	if v, ok := d.GetOk("status"); ok {
-		status = v.(string)
-	}
-
-	listOpts = networks.ListOpts{
-		ID:          d.Get("network_id").(string),
-		Name:        d.Get("name").(string),
-		Description: d.Get("description").(string),
-		TenantID:    d.Get("tenant_id").(string),
-		Status:      status,
-	}
-
-	// Add the external attribute if specified.
-	if v, ok := d.GetOkExists("external"); ok {
-		isExternal := v.(bool)
-		listOpts = external.ListOptsExt{
-			ListOptsBuilder: listOpts,
-			External:        &isExternal,
-		}
-	}
-
-	// Add the transparent VLAN attribute if specified.
-	if v, ok := d.GetOkExists("transparent_vlan"); ok {
-		isVLANTransparent := v.(bool)
-		listOpts = vlantransparent.ListOptsExt{
-			ListOptsBuilder: listOpts,
-			VLANTransparent: &isVLANTransparent,
-		}
-	}
-
-	// Add the MTU attribute if specified.
-	if v, ok := d.GetOkExists("mtu"); ok {
-		listOpts = mtuext.ListOptsExt{
-			ListOptsBuilder: listOpts,
-			MTU:             v.(int),
-		}
-	}
-
-	tags := networkingV2AttributesTags(d)
-	if len(tags) > 0 {
-		listOpts = networks.ListOpts{Tags: strings.Join(tags, ",")}
-	}
+	listOpts := dataSourceNetworkingNetworkV2ListOpts(d)
 
 	pages, err := networks.List(networkingClient, listOpts).AllPages()
 	if err != nil {
@@ -247,3 +207,64 @@ func dataSourceNetworkingNetworkV2Read(d *schema.ResourceData, meta interface{})
 
 	return nil
 }
+
+// dataSourceNetworkingNetworkV2ListOpts builds the list options from the data
+// source arguments, including the ones of the external, vlan-transparent and
+// mtu extensions.
+func dataSourceNetworkingNetworkV2ListOpts(d *schema.ResourceData) networks.ListOptsBuilder {
+	// Prepare basic listOpts.
+	var listOpts networks.ListOptsBuilder
+
+	var status string
+	if v, ok := d.GetOk("status"); ok {
+		status = v.(string)
+	}
+
+	basicOpts := networks.ListOpts{
+		ID:          d.Get("network_id").(string),
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		TenantID:    d.Get("tenant_id").(string),
+		Status:      status,
+	}
+
+	if v, ok := d.GetOk("shared"); ok {
+		shared, _ := strconv.ParseBool(v.(string))
+		basicOpts.Shared = &shared
+	}
+
+	tags := networkingV2AttributesTags(d)
+	if len(tags) > 0 {
+		basicOpts.Tags = strings.Join(tags, ",")
+	}
+
+	listOpts = basicOpts
+
+	// Add the external attribute if specified.
+	if v, ok := d.GetOkExists("external"); ok {
+		isExternal := v.(bool)
+		listOpts = external.ListOptsExt{
+			ListOptsBuilder: listOpts,
+			External:        &isExternal,
+		}
+	}
+
+	// Add the transparent VLAN attribute if specified.
+	if v, ok := d.GetOkExists("transparent_vlan"); ok {
+		isVLANTransparent := v.(bool)
+		listOpts = vlantransparent.ListOptsExt{
+			ListOptsBuilder: listOpts,
+			VLANTransparent: &isVLANTransparent,
+		}
+	}
+
+	// Add the MTU attribute if specified.
+	if v, ok := d.GetOkExists("mtu"); ok {
+		listOpts = mtuext.ListOptsExt{
+			ListOptsBuilder: listOpts,
+			MTU:             v.(int),
+		}
+	}
+
+	return listOpts
+}
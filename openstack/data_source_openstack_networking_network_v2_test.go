@@ -119,6 +119,28 @@ func TestAccOpenStackNetworkingNetworkV2DataSource_externalExplicit(t *testing.T
 	})
 }
 
+func TestAccOpenStackNetworkingNetworkV2DataSource_externalOnly(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpenStackNetworkingNetworkV2DataSourceExternalOnly,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingNetworkV2DataSourceID("data.openstack_networking_network_v2.network_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_network_v2.network_1", "name", osPoolName),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_network_v2.network_1", "external", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccOpenStackNetworkingNetworkV2DataSource_externalImplicit(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -255,6 +277,13 @@ data "openstack_networking_network_v2" "network_1" {
 `, osPoolName)
 }
 
+const testAccOpenStackNetworkingNetworkV2DataSourceExternalOnly = `
+data "openstack_networking_network_v2" "network_1" {
+  external = true
+  shared   = "false"
+}
+`
+
 func testAccOpenStackNetworkingNetworkV2DataSourceExternalImplicit() string {
 	return fmt.Sprintf(`
 data "openstack_networking_network_v2" "network_1" {
@@ -85,6 +85,13 @@ func dataSourceNetworkingPortIDsV2() *schema.Resource {
 				ValidateFunc: validation.SingleIP(),
 			},
 
+			"allowed_address_pair_ip": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.SingleIP(),
+			},
+
 			"status": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -242,6 +249,20 @@ func dataSourceNetworkingPortIDsV2Read(d *schema.ResourceData, meta interface{})
 		portsList = allPorts
 	}
 
+	// Filter returned ports by an "allowed_address_pair_ip".
+	if v, ok := d.GetOk("allowed_address_pair_ip"); ok {
+		var pairPorts []ports.Port
+		for _, p := range portsList {
+			if networkingPortV2AllowedAddressPairsContainIP(p.AllowedAddressPairs, v.(string)) {
+				pairPorts = append(pairPorts, p)
+			}
+		}
+		if len(pairPorts) == 0 {
+			log.Printf("[DEBUG] No ports in openstack_networking_port_ids_v2 found after the 'allowed_address_pair_ip' filter")
+		}
+		portsList = pairPorts
+	}
+
 	securityGroups := expandToStringSlice(d.Get("security_group_ids").(*schema.Set).List())
 	if len(securityGroups) > 0 {
 		var sgPorts []ports.Port
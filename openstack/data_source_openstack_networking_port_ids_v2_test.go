@@ -38,6 +38,34 @@ func TestAccNetworkingV2PortIDsDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccNetworkingV2PortIDsDataSource_allowedAddressPairIP(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2PortIDsDataSourceAllowedAddressPairIP,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.openstack_networking_port_ids_v2.vip_consumers", "ids.#", "2"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_networking_port_ids_v2.vip_consumers", "ids.0",
+						"openstack_networking_port_v2.vrrp_port_1", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_networking_port_ids_v2.vip_consumers", "ids.1",
+						"openstack_networking_port_v2.vrrp_port_2", "id"),
+					resource.TestCheckResourceAttr("data.openstack_networking_port_ids_v2.vip", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_networking_port_ids_v2.vip", "ids.0",
+						"openstack_networking_port_v2.vip_port", "id"),
+				),
+			},
+		},
+	})
+}
+
 const testAccNetworkingV2PortIDsDataSourceBasic = `
 resource "openstack_networking_network_v2" "network_1" {
   name           = "network_1"
@@ -132,3 +160,75 @@ data "openstack_networking_port_ids_v2" "port_2" {
   ]
 }
 `
+
+const testAccNetworkingV2PortIDsDataSourceAllowedAddressPairIP = `
+resource "openstack_networking_network_v2" "vrrp_network" {
+  name           = "vrrp_network"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "vrrp_subnet" {
+  name       = "vrrp_subnet"
+  cidr       = "10.0.0.0/24"
+  ip_version = 4
+  network_id = "${openstack_networking_network_v2.vrrp_network.id}"
+}
+
+resource "openstack_networking_port_v2" "vip_port" {
+  name           = "vip_port"
+  admin_state_up = "true"
+  network_id     = "${openstack_networking_network_v2.vrrp_network.id}"
+
+  fixed_ip {
+    subnet_id  = "${openstack_networking_subnet_v2.vrrp_subnet.id}"
+    ip_address = "10.0.0.200"
+  }
+}
+
+resource "openstack_networking_port_v2" "vrrp_port_1" {
+  name           = "vrrp_port_1"
+  admin_state_up = "true"
+  network_id     = "${openstack_networking_network_v2.vrrp_network.id}"
+
+  fixed_ip {
+    subnet_id  = "${openstack_networking_subnet_v2.vrrp_subnet.id}"
+    ip_address = "10.0.0.201"
+  }
+
+  allowed_address_pairs {
+    ip_address = "${openstack_networking_port_v2.vip_port.fixed_ip.0.ip_address}"
+  }
+}
+
+resource "openstack_networking_port_v2" "vrrp_port_2" {
+  name           = "vrrp_port_2"
+  admin_state_up = "true"
+  network_id     = "${openstack_networking_network_v2.vrrp_network.id}"
+
+  fixed_ip {
+    subnet_id  = "${openstack_networking_subnet_v2.vrrp_subnet.id}"
+    ip_address = "10.0.0.202"
+  }
+
+  allowed_address_pairs {
+    ip_address = "${openstack_networking_port_v2.vip_port.fixed_ip.0.ip_address}"
+  }
+}
+
+data "openstack_networking_port_ids_v2" "vip_consumers" {
+  network_id              = "${openstack_networking_network_v2.vrrp_network.id}"
+  allowed_address_pair_ip = "${openstack_networking_port_v2.vip_port.fixed_ip.0.ip_address}"
+  sort_direction          = "asc"
+  sort_key                = "name"
+
+  depends_on = [
+    "openstack_networking_port_v2.vrrp_port_1",
+    "openstack_networking_port_v2.vrrp_port_2",
+  ]
+}
+
+data "openstack_networking_port_ids_v2" "vip" {
+  network_id = "${openstack_networking_network_v2.vrrp_network.id}"
+  fixed_ip   = "${openstack_networking_port_v2.vip_port.fixed_ip.0.ip_address}"
+}
+`
@@ -0,0 +1,145 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/quotas"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceNetworkingQuotaV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNetworkingQuotaV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"floatingip": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"network": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"rbac_policy": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"router": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"security_group": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"security_group_rule": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"subnet": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"subnetpool": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"details": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"used": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"reserved": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"limit": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetworkingQuotaV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	networkingClient, err := config.NetworkingV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	projectID := d.Get("project_id").(string)
+
+	q, err := quotas.Get(networkingClient, projectID).Extract()
+	if err != nil {
+		return fmt.Errorf("Error retrieving openstack_networking_quota_v2 %s: %s", projectID, err)
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_networking_quota_v2 %s: %#v", projectID, q)
+
+	// The usage is only available with the quota-details extension.
+	var details []map[string]interface{}
+	quotaDetails, err := quotas.GetDetail(networkingClient, projectID).Extract()
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); !ok {
+			return fmt.Errorf("Error retrieving openstack_networking_quota_v2 %s details: %s", projectID, err)
+		}
+		log.Printf("[DEBUG] openstack_networking_quota_v2 details are not available: %s", err)
+	} else {
+		details = flattenNetworkingQuotaV2Details(quotaDetails)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", projectID, region))
+	d.Set("project_id", projectID)
+	d.Set("region", region)
+	d.Set("floatingip", q.FloatingIP)
+	d.Set("network", q.Network)
+	d.Set("port", q.Port)
+	d.Set("rbac_policy", q.RBACPolicy)
+	d.Set("router", q.Router)
+	d.Set("security_group", q.SecurityGroup)
+	d.Set("security_group_rule", q.SecurityGroupRule)
+	d.Set("subnet", q.Subnet)
+	d.Set("subnetpool", q.SubnetPool)
+	d.Set("details", details)
+
+	return nil
+}
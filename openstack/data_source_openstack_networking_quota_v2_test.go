@@ -0,0 +1,42 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccNetworkingV2QuotaDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2QuotaDataSourceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_networking_quota_v2.quota_1", "project_id",
+						"openstack_identity_project_v3.project_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_quota_v2.quota_1", "port", "2"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_networking_quota_v2.quota_1", "details.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNetworkingV2QuotaDataSourceBasic() string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_networking_quota_v2" "quota_1" {
+  project_id = "${openstack_networking_quota_v2.quota_1.project_id}"
+}
+`, testAccNetworkingQuotaV2Basic)
+}
@@ -30,6 +30,7 @@ func dataSourceNetworkingRouterV2() *schema.Resource {
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 			},
 			"admin_state_up": {
 				Type:     schema.TypeBool,
@@ -38,6 +39,11 @@ func dataSourceNetworkingRouterV2() *schema.Resource {
 			"distributed": {
 				Type:     schema.TypeBool,
 				Optional: true,
+				Computed: true,
+			},
+			"ha": {
+				Type:     schema.TypeBool,
+				Computed: true,
 			},
 			"status": {
 				Type:     schema.TypeString,
@@ -98,49 +104,15 @@ func dataSourceNetworkingRouterV2Read(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
-	listOpts := routers.ListOpts{}
-
-	if v, ok := d.GetOk("router_id"); ok {
-		listOpts.ID = v.(string)
-	}
-
-	if v, ok := d.GetOk("name"); ok {
-		listOpts.Name = v.(string)
-	}
-
-	if v, ok := d.GetOk("description"); ok {
-		listOpts.Description = v.(string)
-	}
-
-	if v, ok := d.GetOkExists("admin_state_up"); ok {
-		asu := v.(bool)
-		listOpts.AdminStateUp = &asu
-	}
-
-	if v, ok := d.GetOkExists("distributed"); ok {
-		dist := v.(bool)
-		listOpts.Distributed = &dist
-	}
-
-	if v, ok := d.GetOk("status"); ok {
-		listOpts.Status = v.(string)
-	}
-
-	if v, ok := d.GetOk("tenant_id"); ok {
-		listOpts.TenantID = v.(string)
-	}
-
-	tags := networkingV2AttributesTags(d)
-	if len(tags) > 0 {
-		listOpts.Tags = strings.Join(tags, ",")
-	}
+	listOpts := dataSourceNetworkingRouterV2ListOpts(d)
 
 	pages, err := routers.List(networkingClient, listOpts).AllPages()
 	if err != nil {
 		return fmt.Errorf("Unable to list Routers: %s", err)
 	}
 
-	allRouters, err := routers.ExtractRouters(pages)
+	var allRouters []routerExtended
+	err = pages.(routers.RouterPage).ExtractIntoSlicePtr(&allRouters, "routers")
 	if err != nil {
 		return fmt.Errorf("Unable to retrieve Routers: %s", err)
 	}
@@ -161,7 +133,6 @@ func dataSourceNetworkingRouterV2Read(d *schema.ResourceData, meta interface{})
 	d.Set("name", router.Name)
 	d.Set("description", router.Description)
 	d.Set("admin_state_up", router.AdminStateUp)
-	d.Set("distributed", router.Distributed)
 	d.Set("status", router.Status)
 	d.Set("tenant_id", router.TenantID)
 	d.Set("external_network_id", router.GatewayInfo.NetworkID)
@@ -169,6 +140,14 @@ func dataSourceNetworkingRouterV2Read(d *schema.ResourceData, meta interface{})
 	d.Set("all_tags", router.Tags)
 	d.Set("region", GetRegion(d, config))
 
+	// Only set the extension attributes, which are returned.
+	if router.Distributed != nil {
+		d.Set("distributed", *router.Distributed)
+	}
+	if router.HA != nil {
+		d.Set("ha", *router.HA)
+	}
+
 	if err := d.Set("availability_zone_hints", router.AvailabilityZoneHints); err != nil {
 		log.Printf("[DEBUG] Unable to set availability_zone_hints: %s", err)
 	}
@@ -185,3 +164,47 @@ func dataSourceNetworkingRouterV2Read(d *schema.ResourceData, meta interface{})
 	}
 	return nil
 }
+
+// dataSourceNetworkingRouterV2ListOpts builds the list options from the data
+// source arguments, so that the filtering, including the name, happens on
+// the server.
+func dataSourceNetworkingRouterV2ListOpts(d *schema.ResourceData) routers.ListOpts {
+	listOpts := routers.ListOpts{}
+
+	if v, ok := d.GetOk("router_id"); ok {
+		listOpts.ID = v.(string)
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		listOpts.Name = v.(string)
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		listOpts.Description = v.(string)
+	}
+
+	if v, ok := d.GetOkExists("admin_state_up"); ok {
+		asu := v.(bool)
+		listOpts.AdminStateUp = &asu
+	}
+
+	if v, ok := d.GetOkExists("distributed"); ok {
+		dist := v.(bool)
+		listOpts.Distributed = &dist
+	}
+
+	if v, ok := d.GetOk("status"); ok {
+		listOpts.Status = v.(string)
+	}
+
+	if v, ok := d.GetOk("tenant_id"); ok {
+		listOpts.TenantID = v.(string)
+	}
+
+	tags := networkingV2AttributesTags(d)
+	if len(tags) > 0 {
+		listOpts.Tags = strings.Join(tags, ",")
+	}
+
+	return listOpts
+}
@@ -41,6 +41,29 @@ func TestAccOpenStackNetworkingRouterV2DataSource_name(t *testing.T) {
 	})
 }
 
+func TestAccOpenStackNetworkingRouterV2DataSource_description(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpenStackNetworkingRouterV2DataSourceRouter,
+			},
+			{
+				Config: testAccOpenStackNetworkingRouterV2DataSourceNameOnly(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingRouterV2DataSourceID("data.openstack_networking_router_v2.router"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_router_v2.router", "description", "description"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckNetworkingRouterV2DataSourceID(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -82,3 +105,13 @@ data "openstack_networking_router_v2" "router" {
 }
 `, testAccOpenStackNetworkingRouterV2DataSourceRouter)
 }
+
+func testAccOpenStackNetworkingRouterV2DataSourceNameOnly() string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_networking_router_v2" "router" {
+  name = "${openstack_networking_router_v2.router.name}"
+}
+`, testAccOpenStackNetworkingRouterV2DataSourceRouter)
+}
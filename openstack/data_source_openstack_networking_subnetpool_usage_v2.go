@@ -0,0 +1,163 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/subnetpools"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/subnets"
+)
+
+func dataSourceNetworkingSubnetPoolUsageV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNetworkingSubnetPoolUsageV2Read,
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"subnetpool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"ip_version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"prefixes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"default_quota": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"allocated_cidrs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"total": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"used": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"available": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"project_allocated_cidrs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"project_used": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceNetworkingSubnetPoolUsageV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	subnetPoolID := d.Get("subnetpool_id").(string)
+	subnetPool, err := subnetpools.Get(networkingClient, subnetPoolID).Extract()
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve openstack_networking_subnetpool_v2 %s: %s", subnetPoolID, err)
+	}
+
+	// All visible allocations are listed, because the subnets of every
+	// project take space from the subnetpool.
+	listOpts := subnets.ListOpts{
+		SubnetPoolID: subnetPoolID,
+	}
+
+	pages, err := subnets.List(networkingClient, listOpts).AllPages()
+	if err != nil {
+		return fmt.Errorf("Unable to list subnets of openstack_networking_subnetpool_v2 %s: %s", subnetPoolID, err)
+	}
+
+	allSubnets, err := subnets.ExtractSubnets(pages)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve subnets of openstack_networking_subnetpool_v2 %s: %s", subnetPoolID, err)
+	}
+
+	projectID := d.Get("project_id").(string)
+	allocatedCIDRs := make([]string, 0, len(allSubnets))
+	projectAllocatedCIDRs := make([]string, 0)
+	for _, subnet := range allSubnets {
+		allocatedCIDRs = append(allocatedCIDRs, subnet.CIDR)
+		if projectID != "" && (subnet.ProjectID == projectID || subnet.TenantID == projectID) {
+			projectAllocatedCIDRs = append(projectAllocatedCIDRs, subnet.CIDR)
+		}
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_networking_subnetpool_v2 %s allocations: %v", subnetPoolID, allocatedCIDRs)
+
+	total, err := networkingSubnetPoolV2PrefixSpace(subnetPool.Prefixes)
+	if err != nil {
+		return fmt.Errorf("Unable to compute the prefix space of openstack_networking_subnetpool_v2 %s: %s", subnetPoolID, err)
+	}
+
+	used, err := networkingSubnetPoolV2PrefixSpace(allocatedCIDRs)
+	if err != nil {
+		return fmt.Errorf("Unable to compute the used prefix space of openstack_networking_subnetpool_v2 %s: %s", subnetPoolID, err)
+	}
+
+	projectUsed, err := networkingSubnetPoolV2PrefixSpace(projectAllocatedCIDRs)
+	if err != nil {
+		return fmt.Errorf("Unable to compute the prefix space used by project %s in openstack_networking_subnetpool_v2 %s: %s", projectID, subnetPoolID, err)
+	}
+
+	available := total - used
+	if available < 0 {
+		available = 0
+	}
+
+	if projectID != "" {
+		d.SetId(fmt.Sprintf("%s/%s", subnetPoolID, projectID))
+	} else {
+		d.SetId(subnetPoolID)
+	}
+
+	d.Set("ip_version", subnetPool.IPversion)
+	d.Set("prefixes", subnetPool.Prefixes)
+	d.Set("default_quota", subnetPool.DefaultQuota)
+	d.Set("allocated_cidrs", allocatedCIDRs)
+	d.Set("total", int(total))
+	d.Set("used", int(used))
+	d.Set("available", int(available))
+	d.Set("project_allocated_cidrs", projectAllocatedCIDRs)
+	d.Set("project_used", int(projectUsed))
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
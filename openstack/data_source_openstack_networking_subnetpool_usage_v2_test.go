@@ -0,0 +1,80 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccNetworkingV2SubnetPoolUsageDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpenStackNetworkingSubnetPoolUsageV2DataSourceSubnet,
+			},
+			{
+				Config: testAccOpenStackNetworkingSubnetPoolUsageV2DataSourceBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_networking_subnetpool_usage_v2.usage_1", "subnetpool_id",
+						"openstack_networking_subnetpool_v2.subnetpool_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_subnetpool_usage_v2.usage_1", "ip_version", "4"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_subnetpool_usage_v2.usage_1", "default_quota", "1024"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_subnetpool_usage_v2.usage_1", "allocated_cidrs.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_subnetpool_usage_v2.usage_1", "total", "65792"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_subnetpool_usage_v2.usage_1", "used", "256"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_subnetpool_usage_v2.usage_1", "available", "65536"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_subnetpool_usage_v2.usage_1", "project_allocated_cidrs.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_subnetpool_usage_v2.usage_1", "project_used", "256"),
+				),
+			},
+		},
+	})
+}
+
+const testAccOpenStackNetworkingSubnetPoolUsageV2DataSourceSubnet = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnetpool_v2" "subnetpool_1" {
+  name = "subnetpool_1"
+  prefixes = ["10.10.0.0/16", "10.11.11.0/24"]
+  default_quota = 1024
+  min_prefixlen = 24
+  max_prefixlen = 30
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+  subnetpool_id = "${openstack_networking_subnetpool_v2.subnetpool_1.id}"
+  prefix_length = 24
+}
+`
+
+func testAccOpenStackNetworkingSubnetPoolUsageV2DataSourceBasic() string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_networking_subnetpool_usage_v2" "usage_1" {
+  subnetpool_id = "${openstack_networking_subnetpool_v2.subnetpool_1.id}"
+  project_id = "${openstack_networking_subnet_v2.subnet_1.tenant_id}"
+}
+`, testAccOpenStackNetworkingSubnetPoolUsageV2DataSourceSubnet)
+}
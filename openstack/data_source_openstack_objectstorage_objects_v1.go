@@ -0,0 +1,119 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/objects"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceObjectStorageObjectsV1() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceObjectStorageObjectsV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"container_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"delimiter": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// computed attributes
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"subdirs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"objects": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"content_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"etag": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceObjectStorageObjectsV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	objectStorageClient, err := config.ObjectStorageV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack object storage client: %s", err)
+	}
+
+	containerName := d.Get("container_name").(string)
+	listOpts := objects.ListOpts{
+		Full:      true,
+		Prefix:    d.Get("prefix").(string),
+		Delimiter: d.Get("delimiter").(string),
+	}
+
+	log.Printf("[DEBUG] List Options in openstack_objectstorage_objects_v1: %#v", listOpts)
+
+	allPages, err := objects.List(objectStorageClient, containerName, listOpts).AllPages()
+	if err != nil {
+		return fmt.Errorf("Unable to list objects in openstack_objectstorage_objects_v1: %s", err)
+	}
+
+	allObjects, err := objects.ExtractInfo(allPages)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve objects in openstack_objectstorage_objects_v1: %s", err)
+	}
+
+	log.Printf("[DEBUG] Retrieved %d objects in openstack_objectstorage_objects_v1: %+v", len(allObjects), allObjects)
+
+	names, subdirs, flatObjects := flattenObjectStorageObjectsV1(allObjects)
+
+	d.SetId(fmt.Sprintf("%s/%s", containerName, hashcode.Strings(append(names, subdirs...))))
+	d.Set("names", names)
+	d.Set("subdirs", subdirs)
+	if err := d.Set("objects", flatObjects); err != nil {
+		log.Printf("[DEBUG] Unable to set openstack_objectstorage_objects_v1 objects: %s", err)
+	}
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccObjectStorageV1ObjectsDataSource_prefix(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckSwift(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectStorageV1ObjectsDataSourcePrefix,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.openstack_objectstorage_objects_v1.objects_1", "names.#", "2"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_objectstorage_objects_v1.objects_1", "names.0", "logs/a.txt"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_objectstorage_objects_v1.objects_1", "names.1", "logs/b.txt"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_objectstorage_objects_v1.objects_1", "objects.0.size", "3"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_objectstorage_objects_v1.objects_1", "objects.1.size", "6"),
+				),
+			},
+		},
+	})
+}
+
+const testAccObjectStorageV1ObjectsDataSourcePrefix = `
+resource "openstack_objectstorage_container_v1" "container_1" {
+  name = "tf_test_container_1"
+  force_destroy = true
+}
+
+resource "openstack_objectstorage_object_v1" "object_1" {
+  name = "logs/a.txt"
+  container_name = "${openstack_objectstorage_container_v1.container_1.name}"
+  content = "foo"
+}
+
+resource "openstack_objectstorage_object_v1" "object_2" {
+  name = "logs/b.txt"
+  container_name = "${openstack_objectstorage_container_v1.container_1.name}"
+  content = "foobar"
+}
+
+resource "openstack_objectstorage_object_v1" "object_3" {
+  name = "data/c.txt"
+  container_name = "${openstack_objectstorage_container_v1.container_1.name}"
+  content = "bar"
+}
+
+data "openstack_objectstorage_objects_v1" "objects_1" {
+  container_name = "${openstack_objectstorage_container_v1.container_1.name}"
+  prefix = "logs/"
+
+  depends_on = [
+    "openstack_objectstorage_object_v1.object_1",
+    "openstack_objectstorage_object_v1.object_2",
+    "openstack_objectstorage_object_v1.object_3",
+  ]
+}
+`
@@ -0,0 +1,68 @@
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// dnsQuotaV2 represents the Designate quotas of a project.
+type dnsQuotaV2 struct {
+	APIExportSize    int `json:"api_export_size"`
+	RecordsetRecords int `json:"recordset_records"`
+	ZoneRecords      int `json:"zone_records"`
+	ZoneRecordsets   int `json:"zone_recordsets"`
+	Zones            int `json:"zones"`
+}
+
+// dnsQuotaV2UpdateOpts are the quotas to update. Omitted quotas are kept
+// unchanged.
+type dnsQuotaV2UpdateOpts struct {
+	APIExportSize    *int `json:"api_export_size,omitempty"`
+	RecordsetRecords *int `json:"recordset_records,omitempty"`
+	ZoneRecords      *int `json:"zone_records,omitempty"`
+	ZoneRecordsets   *int `json:"zone_recordsets,omitempty"`
+	Zones            *int `json:"zones,omitempty"`
+}
+
+// dnsQuotaV2ClientSetAuthHeader allows to manage the quotas of another
+// project.
+func dnsQuotaV2ClientSetAuthHeader(dnsClient *gophercloud.ServiceClient) {
+	dnsClient.MoreHeaders = map[string]string{
+		headerAuthAllProjects: "true",
+	}
+}
+
+// dnsQuotaV2Get retrieves the quotas of a project. gophercloud doesn't
+// support the Designate quotas API yet.
+func dnsQuotaV2Get(client *gophercloud.ServiceClient, projectID string) (*dnsQuotaV2, error) {
+	var r gophercloud.Result
+	_, r.Err = client.Get(client.ServiceURL("quotas", projectID), &r.Body, nil)
+
+	var q dnsQuotaV2
+	err := r.ExtractInto(&q)
+	if err != nil {
+		return nil, err
+	}
+
+	return &q, nil
+}
+
+// dnsQuotaV2Update updates the quotas of a project.
+func dnsQuotaV2Update(client *gophercloud.ServiceClient, projectID string, opts dnsQuotaV2UpdateOpts) (*dnsQuotaV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var r gophercloud.Result
+	_, r.Err = client.Patch(client.ServiceURL("quotas", projectID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	var q dnsQuotaV2
+	err = r.ExtractInto(&q)
+	if err != nil {
+		return nil, err
+	}
+
+	return &q, nil
+}
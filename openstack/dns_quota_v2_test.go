@@ -0,0 +1,69 @@
+package openstack
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	thclient "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/stretchr/testify/assert"
+)
+
+const testDNSQuotaV2Response = `
+{
+  "api_export_size": 1000,
+  "recordset_records": 20,
+  "zone_records": 500,
+  "zone_recordsets": 500,
+  "zones": 10
+}
+`
+
+func TestDNSQuotaV2Get(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/quotas/3a705b9f56bb439381b43c4fe59dccce", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, testDNSQuotaV2Response)
+	})
+
+	q, err := dnsQuotaV2Get(thclient.ServiceClient(), "3a705b9f56bb439381b43c4fe59dccce")
+
+	expected := &dnsQuotaV2{
+		APIExportSize:    1000,
+		RecordsetRecords: 20,
+		ZoneRecords:      500,
+		ZoneRecordsets:   500,
+		Zones:            10,
+	}
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, q)
+}
+
+func TestDNSQuotaV2Update(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/quotas/3a705b9f56bb439381b43c4fe59dccce", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PATCH")
+		th.TestJSONRequest(t, r, `{"zones": 10}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, testDNSQuotaV2Response)
+	})
+
+	zones := 10
+	q, err := dnsQuotaV2Update(thclient.ServiceClient(), "3a705b9f56bb439381b43c4fe59dccce", dnsQuotaV2UpdateOpts{
+		Zones: &zones,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 10, q.Zones)
+}
@@ -0,0 +1,103 @@
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// identityLimitV3 represents a project limit of the Keystone unified limits
+// API.
+type identityLimitV3 struct {
+	ID            string `json:"id"`
+	ProjectID     string `json:"project_id"`
+	DomainID      string `json:"domain_id"`
+	ServiceID     string `json:"service_id"`
+	RegionID      string `json:"region_id"`
+	ResourceName  string `json:"resource_name"`
+	ResourceLimit int    `json:"resource_limit"`
+	Description   string `json:"description"`
+}
+
+// identityLimitV3ListOpts are the filters of identityLimitV3List.
+type identityLimitV3ListOpts struct {
+	ProjectID    string `q:"project_id"`
+	ServiceID    string `q:"service_id"`
+	RegionID     string `q:"region_id"`
+	ResourceName string `q:"resource_name"`
+}
+
+// identityLimitV3List lists the limits using the unified limits API, which
+// isn't supported by gophercloud yet.
+func identityLimitV3List(client *gophercloud.ServiceClient, opts identityLimitV3ListOpts) ([]identityLimitV3, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var r gophercloud.Result
+	_, r.Err = client.Get(client.ServiceURL("limits")+q.String(), &r.Body, nil)
+
+	var s struct {
+		Limits []identityLimitV3 `json:"limits"`
+	}
+	err = r.ExtractInto(&s)
+
+	return s.Limits, err
+}
+
+// identityRegisteredLimitV3 represents a registered limit, i.e. the default
+// limit of a resource, of the Keystone unified limits API.
+type identityRegisteredLimitV3 struct {
+	ID           string `json:"id"`
+	ServiceID    string `json:"service_id"`
+	RegionID     string `json:"region_id"`
+	ResourceName string `json:"resource_name"`
+	DefaultLimit int    `json:"default_limit"`
+	Description  string `json:"description"`
+}
+
+// identityRegisteredLimitV3ListOpts are the filters of
+// identityRegisteredLimitV3List.
+type identityRegisteredLimitV3ListOpts struct {
+	ServiceID    string `q:"service_id"`
+	RegionID     string `q:"region_id"`
+	ResourceName string `q:"resource_name"`
+}
+
+// identityRegisteredLimitV3List lists the registered limits using the unified
+// limits API.
+func identityRegisteredLimitV3List(client *gophercloud.ServiceClient, opts identityRegisteredLimitV3ListOpts) ([]identityRegisteredLimitV3, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var r gophercloud.Result
+	_, r.Err = client.Get(client.ServiceURL("registered_limits")+q.String(), &r.Body, nil)
+
+	var s struct {
+		RegisteredLimits []identityRegisteredLimitV3 `json:"registered_limits"`
+	}
+	err = r.ExtractInto(&s)
+
+	return s.RegisteredLimits, err
+}
+
+// identityLimitModelV3 represents the enforcement model of the unified
+// limits, e.g. "flat" or "strict-two-level".
+type identityLimitModelV3 struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// identityLimitModelV3Get retrieves the active enforcement model.
+func identityLimitModelV3Get(client *gophercloud.ServiceClient) (*identityLimitModelV3, error) {
+	var r gophercloud.Result
+	_, r.Err = client.Get(client.ServiceURL("limits", "model"), &r.Body, nil)
+
+	var s struct {
+		Model *identityLimitModelV3 `json:"model"`
+	}
+	err := r.ExtractInto(&s)
+
+	return s.Model, err
+}
@@ -0,0 +1,140 @@
+package openstack
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	thclient "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIdentityLimitV3List(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/limits", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestFormValues(t, r, map[string]string{
+			"project_id":    "3a705b9f56bb439381b43c4fe59dccce",
+			"service_id":    "9408080f1970482aa0e38bc2d4ea34b7",
+			"resource_name": "snapshot",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{
+  "limits": [
+    {
+      "id": "25a04c7a065c430590881c646cdcdd58",
+      "project_id": "3a705b9f56bb439381b43c4fe59dccce",
+      "domain_id": null,
+      "service_id": "9408080f1970482aa0e38bc2d4ea34b7",
+      "region_id": "RegionOne",
+      "resource_name": "snapshot",
+      "resource_limit": 5,
+      "description": "Number of snapshots for project 3a705b9f56bb439381b43c4fe59dccce"
+    }
+  ]
+}`)
+	})
+
+	limits, err := identityLimitV3List(thclient.ServiceClient(), identityLimitV3ListOpts{
+		ProjectID:    "3a705b9f56bb439381b43c4fe59dccce",
+		ServiceID:    "9408080f1970482aa0e38bc2d4ea34b7",
+		ResourceName: "snapshot",
+	})
+
+	expected := []identityLimitV3{
+		{
+			ID:            "25a04c7a065c430590881c646cdcdd58",
+			ProjectID:     "3a705b9f56bb439381b43c4fe59dccce",
+			ServiceID:     "9408080f1970482aa0e38bc2d4ea34b7",
+			RegionID:      "RegionOne",
+			ResourceName:  "snapshot",
+			ResourceLimit: 5,
+			Description:   "Number of snapshots for project 3a705b9f56bb439381b43c4fe59dccce",
+		},
+	}
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, limits)
+}
+
+func TestIdentityRegisteredLimitV3List(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/registered_limits", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestFormValues(t, r, map[string]string{
+			"service_id":    "9408080f1970482aa0e38bc2d4ea34b7",
+			"region_id":     "RegionOne",
+			"resource_name": "snapshot",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{
+  "registered_limits": [
+    {
+      "id": "773147dd53cd4a17b921d555cf17c633",
+      "service_id": "9408080f1970482aa0e38bc2d4ea34b7",
+      "region_id": "RegionOne",
+      "resource_name": "snapshot",
+      "default_limit": 10,
+      "description": "Number of snapshots per project"
+    }
+  ]
+}`)
+	})
+
+	registeredLimits, err := identityRegisteredLimitV3List(thclient.ServiceClient(), identityRegisteredLimitV3ListOpts{
+		ServiceID:    "9408080f1970482aa0e38bc2d4ea34b7",
+		RegionID:     "RegionOne",
+		ResourceName: "snapshot",
+	})
+
+	expected := []identityRegisteredLimitV3{
+		{
+			ID:           "773147dd53cd4a17b921d555cf17c633",
+			ServiceID:    "9408080f1970482aa0e38bc2d4ea34b7",
+			RegionID:     "RegionOne",
+			ResourceName: "snapshot",
+			DefaultLimit: 10,
+			Description:  "Number of snapshots per project",
+		},
+	}
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, registeredLimits)
+}
+
+func TestIdentityLimitModelV3Get(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/limits/model", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{
+  "model": {
+    "name": "flat",
+    "description": "Limit enforcement and validation does not take project hierarchy into consideration."
+  }
+}`)
+	})
+
+	model, err := identityLimitModelV3Get(thclient.ServiceClient())
+
+	expected := &identityLimitModelV3{
+		Name:        "flat",
+		Description: "Limit enforcement and validation does not take project hierarchy into consideration.",
+	}
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, model)
+}
@@ -2,8 +2,12 @@ package openstack
 
 import (
 	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
 	"encoding/hex"
 	"fmt"
+	"hash"
 	"io"
 	"log"
 	"net/http"
@@ -11,15 +15,84 @@ import (
 	"path/filepath"
 	"regexp"
 	"strings"
+	"sync"
 
 	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/imageimport"
 	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/images"
 	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/members"
+	"github.com/gophercloud/gophercloud/pagination"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
+// imagesImageV2ListCache caches the images returned by identical list
+// queries, so that data sources with the same filters only query Glance once
+// per Terraform run. openstack_images_image_v2 clears it whenever it changes
+// an image. The zero value is ready to use.
+type imagesImageV2ListCache struct {
+	mu      sync.Mutex
+	entries map[string]*imagesImageV2ListCacheEntry
+}
+
+type imagesImageV2ListCacheEntry struct {
+	once   sync.Once
+	images []images.Image
+	err    error
+}
+
+// List returns the images matching opts. Concurrent identical queries wait
+// for the first one, failed queries aren't cached.
+func (c *imagesImageV2ListCache) List(client *gophercloud.ServiceClient, opts images.ListOpts) ([]images.Image, error) {
+	query, err := opts.ToImageListQuery()
+	if err != nil {
+		return nil, err
+	}
+	key := client.ServiceURL("images") + query
+
+	c.mu.Lock()
+	if c.entries == nil {
+		c.entries = make(map[string]*imagesImageV2ListCacheEntry)
+	}
+	entry, ok := c.entries[key]
+	if !ok {
+		entry = &imagesImageV2ListCacheEntry{}
+		c.entries[key] = entry
+	}
+	c.mu.Unlock()
+
+	entry.once.Do(func() {
+		var allPages pagination.Page
+		allPages, entry.err = images.List(client, opts).AllPages()
+		if entry.err == nil {
+			entry.images, entry.err = images.ExtractImages(allPages)
+		}
+	})
+
+	if entry.err != nil {
+		c.mu.Lock()
+		if c.entries[key] == entry {
+			delete(c.entries, key)
+		}
+		c.mu.Unlock()
+
+		return nil, entry.err
+	}
+
+	log.Printf("[DEBUG] Image list cache returned %d images for %s", len(entry.images), key)
+
+	// Hand out a copy, so that callers can't modify the cached list.
+	return append([]images.Image(nil), entry.images...), nil
+}
+
+// Clear drops all cached queries.
+func (c *imagesImageV2ListCache) Clear() {
+	c.mu.Lock()
+	c.entries = nil
+	c.mu.Unlock()
+}
+
 func resourceImagesImageV2MemberStatusFromString(v string) images.ImageMemberStatus {
 	switch v {
 	case string(images.ImageMemberStatusAccepted):
@@ -58,6 +131,106 @@ func fileMD5Checksum(f *os.File) (string, error) {
 	return hex.EncodeToString(hash.Sum(nil)), nil
 }
 
+// imagesImageV2NewHash returns a hash for the os_hash_algo reported by the
+// Image service.
+func imagesImageV2NewHash(algo string) (hash.Hash, error) {
+	switch strings.ToLower(algo) {
+	case "md5":
+		return md5.New(), nil
+	case "sha1":
+		return sha1.New(), nil
+	case "sha224":
+		return sha256.New224(), nil
+	case "sha256":
+		return sha256.New(), nil
+	case "sha384":
+		return sha512.New384(), nil
+	case "sha512":
+		return sha512.New(), nil
+	}
+
+	return nil, fmt.Errorf("unsupported hash algorithm %q", algo)
+}
+
+func imagesImageV2FileHash(filename string, h hash.Hash) (string, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	if _, err := io.Copy(h, file); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// imagesImageV2VerifyChecksum compares the checksum and, when available, the
+// os_hash_value reported by the Image service with the uploaded file.
+func imagesImageV2VerifyChecksum(img *images.Image, filename, fileChecksum string) error {
+	if img.Checksum != fileChecksum {
+		return fmt.Errorf("Error wrong checksum: got %q, expected %q", img.Checksum, fileChecksum)
+	}
+
+	// Multihash is only reported by Glance since Rocky.
+	algo, _ := img.Properties["os_hash_algo"].(string)
+	value, _ := img.Properties["os_hash_value"].(string)
+	if algo == "" || value == "" {
+		log.Printf("[DEBUG] Image %s has no os_hash_value, only checksum was verified", img.ID)
+		return nil
+	}
+
+	h, err := imagesImageV2NewHash(algo)
+	if err != nil {
+		log.Printf("[WARN] Unable to verify os_hash_value of image %s: %s", img.ID, err)
+		return nil
+	}
+
+	fileHash, err := imagesImageV2FileHash(filename, h)
+	if err != nil {
+		return fmt.Errorf("Error computing image file %q %s hash: %s", filename, algo, err)
+	}
+
+	if value != fileHash {
+		return fmt.Errorf("Error wrong %s hash: got %q, expected %q", algo, value, fileHash)
+	}
+
+	return nil
+}
+
+// imagesImageV2SuppressConvertedDiskFormat ignores the disk_format reported
+// by the Image service when it converted the image during the import.
+func imagesImageV2SuppressConvertedDiskFormat(k, old, new string, d *schema.ResourceData) bool {
+	convertTo := d.Get("convert_to_format").(string)
+	return convertTo != "" && d.Get("web_download").(bool) && old == convertTo
+}
+
+// imagesImageV2CheckConvertToFormat fails before the image is created when
+// convert_to_format can't apply. It isn't sent to the Image service, only the
+// image_conversion plugin of a web-download import converts the image.
+func imagesImageV2CheckConvertToFormat(client *gophercloud.ServiceClient, d *schema.ResourceData) error {
+	if d.Get("convert_to_format").(string) == "" {
+		return nil
+	}
+
+	if !d.Get("web_download").(bool) {
+		return fmt.Errorf("convert_to_format requires web_download to be true")
+	}
+
+	info, err := imageimport.Get(client).Extract()
+	if err != nil {
+		return fmt.Errorf("Error retrieving the Image service import methods: %s", err)
+	}
+
+	if !strSliceContains(info.ImportMethods.Value, string(imageimport.WebDownloadMethod)) {
+		return fmt.Errorf("convert_to_format requires the %s import method, the Image service only supports %v",
+			imageimport.WebDownloadMethod, info.ImportMethods.Value)
+	}
+
+	return nil
+}
+
 func resourceImagesImageV2FileProps(filename string) (int64, string, error) {
 	var filesize int64
 	var filechecksum string
@@ -170,6 +343,38 @@ func resourceImagesImageV2ExpandProperties(v map[string]interface{}) map[string]
 	return properties
 }
 
+// flattenImagesImageV2Locations converts the locations reported by the Image
+// service. They are only returned when show_multiple_locations is enabled.
+func flattenImagesImageV2Locations(v interface{}) []map[string]interface{} {
+	raw, ok := v.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	locations := make([]map[string]interface{}, 0, len(raw))
+	for _, l := range raw {
+		location, ok := l.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		url, _ := location["url"].(string)
+		metadata := make(map[string]string)
+		if m, ok := location["metadata"].(map[string]interface{}); ok {
+			for k, v := range m {
+				metadata[k] = fmt.Sprintf("%v", v)
+			}
+		}
+
+		locations = append(locations, map[string]interface{}{
+			"url":      url,
+			"metadata": metadata,
+		})
+	}
+
+	return locations
+}
+
 func resourceImagesImageV2UpdateComputedAttributes(diff *schema.ResourceDiff, meta interface{}) error {
 	if diff.HasChange("properties") {
 		// Only check if the image has been created.
@@ -184,22 +389,7 @@ func resourceImagesImageV2UpdateComputedAttributes(diff *schema.ResourceDiff, me
 			newProperties := resourceImagesImageV2ExpandProperties(n.(map[string]interface{}))
 
 			for oldKey, oldValue := range o.(map[string]interface{}) {
-				// os_ keys are provided by the OpenStack Image service.
-				if strings.HasPrefix(oldKey, "os_") {
-					if v, ok := oldValue.(string); ok {
-						newProperties[oldKey] = v
-					}
-				}
-
-				// stores is provided by the OpenStack Image service.
-				if oldKey == "stores" {
-					if v, ok := oldValue.(string); ok {
-						newProperties[oldKey] = v
-					}
-				}
-
-				// direct_url is provided by some storage drivers.
-				if oldKey == "direct_url" {
+				if imagesImageV2ReadOnlyProperty(oldKey) {
 					if v, ok := oldValue.(string); ok {
 						newProperties[oldKey] = v
 					}
@@ -220,6 +410,89 @@ func resourceImagesImageV2UpdateComputedAttributes(diff *schema.ResourceDiff, me
 	return nil
 }
 
+// imagesImageV2ReadOnlyProperty reports whether an image property is provided
+// by the Image service and can't be modified.
+func imagesImageV2ReadOnlyProperty(key string) bool {
+	// os_ keys are provided by the OpenStack Image service.
+	if strings.HasPrefix(key, "os_") {
+		return true
+	}
+
+	switch key {
+	// stores is provided by the OpenStack Image service.
+	case "stores":
+		return true
+	// direct_url is provided by some storage drivers.
+	case "direct_url":
+		return true
+	}
+
+	return false
+}
+
+// imagesImageV2ReplaceProtected represents an updated protected property
+// request, which gophercloud doesn't support yet.
+type imagesImageV2ReplaceProtected struct {
+	NewProtected bool
+}
+
+// ToImagePatchMap assembles a request body based on imagesImageV2ReplaceProtected.
+func (r imagesImageV2ReplaceProtected) ToImagePatchMap() map[string]interface{} {
+	return map[string]interface{}{
+		"op":    "replace",
+		"path":  "/protected",
+		"value": r.NewProtected,
+	}
+}
+
+// expandImagesImageV2PropertiesUpdateOpts builds the JSON patch operations,
+// which turn the old image properties into the new ones. Read-only properties
+// are left alone, CustomizeDiff handles them.
+func expandImagesImageV2PropertiesUpdateOpts(oldProperties, newProperties map[string]string) images.UpdateOpts {
+	var updateOpts images.UpdateOpts
+
+	// Check for new and changed properties
+	for newKey, newValue := range newProperties {
+		if imagesImageV2ReadOnlyProperty(newKey) {
+			continue
+		}
+
+		oldValue, found := oldProperties[newKey]
+		if !found {
+			updateOpts = append(updateOpts, images.UpdateImageProperty{
+				Op:    images.AddOp,
+				Name:  newKey,
+				Value: newValue,
+			})
+			continue
+		}
+
+		if newValue != oldValue {
+			updateOpts = append(updateOpts, images.UpdateImageProperty{
+				Op:    images.ReplaceOp,
+				Name:  newKey,
+				Value: newValue,
+			})
+		}
+	}
+
+	// Check for removed properties
+	for oldKey := range oldProperties {
+		if imagesImageV2ReadOnlyProperty(oldKey) {
+			continue
+		}
+
+		if _, found := newProperties[oldKey]; !found {
+			updateOpts = append(updateOpts, images.UpdateImageProperty{
+				Op:   images.RemoveOp,
+				Name: oldKey,
+			})
+		}
+	}
+
+	return updateOpts
+}
+
 func resourceImagesImageAccessV2ParseID(id string) (string, string, error) {
 	idParts := strings.Split(id, "/")
 	if len(idParts) < 2 {
@@ -235,6 +508,10 @@ func resourceImagesImageAccessV2ParseID(id string) (string, string, error) {
 func resourceImagesImageAccessV2DetectMemberID(client *gophercloud.ServiceClient, imageID string) (string, error) {
 	allPages, err := members.List(client, imageID).AllPages()
 	if err != nil {
+		// A 404 is returned as is, so that callers can retry it.
+		if _, ok := err.(gophercloud.ErrDefault404); ok {
+			return "", err
+		}
 		return "", fmt.Errorf("Unable to list image members: %s", err)
 	}
 	allMembers, err := members.ExtractMembers(allPages)
@@ -250,6 +527,59 @@ func resourceImagesImageAccessV2DetectMemberID(client *gophercloud.ServiceClient
 	return allMembers[0].MemberID, nil
 }
 
+// imagesImageAccessAcceptV2CheckRetryable also retries a 404, since a member
+// can briefly be unknown to the consumer after it was added by the producer.
+func imagesImageAccessAcceptV2CheckRetryable(err error) *resource.RetryError {
+	if _, ok := err.(gophercloud.ErrDefault404); ok {
+		return resource.RetryableError(err)
+	}
+
+	return checkForRetryableError(err)
+}
+
+// imagesImageAccessAcceptV2CheckDeleted handles an image member which is gone,
+// e.g. because the image owner revoked the share. It is removed from the state
+// unless fail_on_revoked is set, in which case it's kept with an empty status,
+// so that the revocation shows up in the plan and fails the apply, while the
+// resource can still be destroyed.
+func imagesImageAccessAcceptV2CheckDeleted(d *schema.ResourceData, err error) error {
+	if _, ok := err.(gophercloud.ErrDefault404); ok && d.Get("fail_on_revoked").(bool) {
+		log.Printf("[WARN] The openstack_images_image_access_accept_v2 %s share was revoked by the image owner", d.Id())
+		d.Set("status", "")
+		return nil
+	}
+
+	return CheckDeleted(d, err, "Error retrieving the openstack_images_image_access_accept_v2")
+}
+
+// imagesImageV2WarnInactiveMembers logs the members of a shared image which
+// lose access to it when its visibility changes.
+func imagesImageV2WarnInactiveMembers(client *gophercloud.ServiceClient, imageID string, visibility images.ImageVisibility) {
+	allPages, err := members.List(client, imageID).AllPages()
+	if err != nil {
+		log.Printf("[DEBUG] Unable to list members of image %s: %s", imageID, err)
+		return
+	}
+
+	allMembers, err := members.ExtractMembers(allPages)
+	if err != nil {
+		log.Printf("[DEBUG] Unable to extract members of image %s: %s", imageID, err)
+		return
+	}
+
+	if len(allMembers) == 0 {
+		return
+	}
+
+	memberIDs := make([]string, 0, len(allMembers))
+	for _, member := range allMembers {
+		memberIDs = append(memberIDs, member.MemberID)
+	}
+
+	log.Printf("[WARN] Image %s is no longer shared (%s): members %s keep their membership but lose access until the image is shared again",
+		imageID, visibility, strings.Join(memberIDs, ", "))
+}
+
 func imagesFilterByRegex(imageArr []images.Image, nameRegex string) []images.Image {
 	var result []images.Image
 	r := regexp.MustCompile(nameRegex)
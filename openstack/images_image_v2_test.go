@@ -0,0 +1,298 @@
+package openstack
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/images"
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/members"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	thclient "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImagesImageV2VerifyChecksum(t *testing.T) {
+	tmpFile, err := ioutil.TempFile("", "tf_test_images_image")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(tmpFile.Name())
+
+	if _, err := tmpFile.Write([]byte("foo")); err != nil {
+		t.Fatal(err)
+	}
+	if err := tmpFile.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	md5Checksum := "acbd18db4cc2f85cedef654fccc4a4d8"
+	sha256Hash := "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae"
+
+	img := &images.Image{
+		Checksum: md5Checksum,
+	}
+	assert.NoError(t, imagesImageV2VerifyChecksum(img, tmpFile.Name(), md5Checksum))
+
+	img.Properties = map[string]interface{}{
+		"os_hash_algo":  "sha256",
+		"os_hash_value": sha256Hash,
+	}
+	assert.NoError(t, imagesImageV2VerifyChecksum(img, tmpFile.Name(), md5Checksum))
+
+	img.Properties["os_hash_value"] = "bad"
+	assert.Error(t, imagesImageV2VerifyChecksum(img, tmpFile.Name(), md5Checksum))
+
+	img.Properties["os_hash_algo"] = "unknown"
+	assert.NoError(t, imagesImageV2VerifyChecksum(img, tmpFile.Name(), md5Checksum))
+
+	img.Checksum = "bad"
+	assert.Error(t, imagesImageV2VerifyChecksum(img, tmpFile.Name(), md5Checksum))
+}
+
+func TestFlattenImagesImageV2Locations(t *testing.T) {
+	raw := []interface{}{
+		map[string]interface{}{
+			"url": "rbd://fsid/images/id/snap",
+			"metadata": map[string]interface{}{
+				"store": "ceph",
+			},
+		},
+		map[string]interface{}{
+			"url": "file:///var/lib/glance/images/id",
+		},
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"url": "rbd://fsid/images/id/snap",
+			"metadata": map[string]string{
+				"store": "ceph",
+			},
+		},
+		{
+			"url":      "file:///var/lib/glance/images/id",
+			"metadata": map[string]string{},
+		},
+	}
+
+	assert.Equal(t, expected, flattenImagesImageV2Locations(raw))
+	assert.Empty(t, flattenImagesImageV2Locations(nil))
+}
+
+func TestMostRecentImage(t *testing.T) {
+	now := time.Now()
+	allImages := []images.Image{
+		{
+			ID:        "newest",
+			CreatedAt: now,
+		},
+		{
+			ID:        "oldest",
+			CreatedAt: now.Add(-time.Hour),
+		},
+	}
+
+	assert.Equal(t, "newest", mostRecentImage(allImages).ID)
+}
+
+func TestImagesImageAccessAcceptV2CheckRetryable(t *testing.T) {
+	assert.True(t, imagesImageAccessAcceptV2CheckRetryable(gophercloud.ErrDefault404{}).Retryable)
+	assert.True(t, imagesImageAccessAcceptV2CheckRetryable(gophercloud.ErrDefault409{}).Retryable)
+	assert.False(t, imagesImageAccessAcceptV2CheckRetryable(gophercloud.ErrDefault403{}).Retryable)
+	assert.False(t, imagesImageAccessAcceptV2CheckRetryable(fmt.Errorf("foo")).Retryable)
+}
+
+func TestImagesImageAccessAcceptV2CheckDeleted(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/images/image_id/members/member_id", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, revokedErr := members.Get(thclient.ServiceClient(), "image_id", "member_id").Extract()
+	assert.Error(t, revokedErr)
+
+	resourceSchema := resourceImagesImageAccessAcceptV2().Schema
+
+	d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{
+		"image_id": "image_id",
+		"status":   "accepted",
+	})
+	d.SetId("image_id/member_id")
+	assert.NoError(t, imagesImageAccessAcceptV2CheckDeleted(d, revokedErr))
+	assert.Equal(t, "", d.Id())
+
+	d = schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{
+		"image_id":        "image_id",
+		"status":          "accepted",
+		"fail_on_revoked": true,
+	})
+	d.SetId("image_id/member_id")
+	assert.NoError(t, imagesImageAccessAcceptV2CheckDeleted(d, revokedErr))
+	assert.Equal(t, "image_id/member_id", d.Id())
+	assert.Equal(t, "", d.Get("status"))
+}
+
+func TestResourceImagesImageAccessV2DetectMemberID(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/images/image_id/members", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `
+{
+  "members": [
+    {
+      "created_at": "2013-10-07T17:58:03Z",
+      "image_id": "image_id",
+      "member_id": "project_1",
+      "schema": "/v2/schemas/member",
+      "status": "pending",
+      "updated_at": "2013-10-07T17:58:03Z"
+    },
+    {
+      "created_at": "2013-10-07T17:58:55Z",
+      "image_id": "image_id",
+      "member_id": "project_2",
+      "schema": "/v2/schemas/member",
+      "status": "accepted",
+      "updated_at": "2013-10-08T12:08:55Z"
+    }
+  ],
+  "schema": "/v2/schemas/members"
+}`)
+	})
+
+	memberID, err := resourceImagesImageAccessV2DetectMemberID(thclient.ServiceClient(), "image_id")
+	assert.EqualError(t, err, `Too many members found for the "image_id" image, please specify the member_id explicitly`)
+	assert.Equal(t, "", memberID)
+}
+
+func TestExpandImagesImageV2PropertiesUpdateOpts(t *testing.T) {
+	oldProperties := map[string]string{
+		"foo":           "bar",
+		"bar":           "foo",
+		"baz":           "qux",
+		"os_hash_value": "abc",
+		"stores":        "file",
+	}
+
+	newProperties := map[string]string{
+		"foo":   "bar",
+		"baz":   "quux",
+		"qux":   "baz",
+		"os_me": "1",
+	}
+
+	expected := images.UpdateOpts{
+		images.UpdateImageProperty{
+			Op:    images.ReplaceOp,
+			Name:  "baz",
+			Value: "quux",
+		},
+		images.UpdateImageProperty{
+			Op:    images.AddOp,
+			Name:  "qux",
+			Value: "baz",
+		},
+		images.UpdateImageProperty{
+			Op:   images.RemoveOp,
+			Name: "bar",
+		},
+	}
+
+	actual := expandImagesImageV2PropertiesUpdateOpts(oldProperties, newProperties)
+	assert.ElementsMatch(t, expected, actual)
+}
+
+func TestImagesImageV2ReplaceProtected(t *testing.T) {
+	expected := map[string]interface{}{
+		"op":    "replace",
+		"path":  "/protected",
+		"value": true,
+	}
+
+	actual := imagesImageV2ReplaceProtected{NewProtected: true}.ToImagePatchMap()
+	assert.Equal(t, expected, actual)
+}
+
+func TestImagesImageV2CheckConvertToFormat(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	methods := `["glance-direct", "web-download"]`
+	th.Mux.HandleFunc("/info/import", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"import-methods": {"type": "array", "value": %s}}`, methods)
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceImagesImageV2().Schema, map[string]interface{}{
+		"name":        "image_1",
+		"disk_format": "qcow2",
+	})
+	assert.NoError(t, imagesImageV2CheckConvertToFormat(thclient.ServiceClient(), d))
+
+	d.Set("convert_to_format", "raw")
+	assert.Error(t, imagesImageV2CheckConvertToFormat(thclient.ServiceClient(), d))
+
+	d.Set("web_download", true)
+	assert.NoError(t, imagesImageV2CheckConvertToFormat(thclient.ServiceClient(), d))
+
+	methods = `["glance-direct"]`
+	assert.Error(t, imagesImageV2CheckConvertToFormat(thclient.ServiceClient(), d))
+}
+
+func TestImagesImageV2ListCache(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	var calls int32
+	th.Mux.HandleFunc("/images", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		atomic.AddInt32(&calls, 1)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"images": [{"id": "image_%s", "name": "%s"}]}`, r.URL.Query().Get("name"), r.URL.Query().Get("name"))
+	})
+
+	var cache imagesImageV2ListCache
+	client := thclient.ServiceClient()
+
+	allImages, err := cache.List(client, images.ListOpts{Name: "foo", Tags: []string{"bar"}})
+	assert.NoError(t, err)
+	assert.Len(t, allImages, 1)
+	assert.Equal(t, "image_foo", allImages[0].ID)
+
+	allImages, err = cache.List(client, images.ListOpts{Name: "foo", Tags: []string{"bar"}})
+	assert.NoError(t, err)
+	assert.Len(t, allImages, 1)
+	assert.Equal(t, "image_foo", allImages[0].ID)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+
+	allImages, err = cache.List(client, images.ListOpts{Name: "baz"})
+	assert.NoError(t, err)
+	assert.Len(t, allImages, 1)
+	assert.Equal(t, "image_baz", allImages[0].ID)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+
+	cache.Clear()
+
+	allImages, err = cache.List(client, images.ListOpts{Name: "foo", Tags: []string{"bar"}})
+	assert.NoError(t, err)
+	assert.Len(t, allImages, 1)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+}
@@ -0,0 +1,88 @@
+package openstack
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccNetworkingV2PortSecGroupAssociate_importEnforce(t *testing.T) {
+	resourceName := "openstack_networking_port_secgroup_associate_v2.port_1"
+
+	if os.Getenv("TF_ACC") != "" {
+		hiddenPort, err := testAccCheckNetworkingV2PortSecGroupCreatePort(t, "hidden_port", true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer testAccCheckNetworkingV2PortSecGroupDeletePort(t, hiddenPort) //nolint:errcheck
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2PortSecGroupAssociateManifestUpdate3(),
+			},
+
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccNetworkingV2PortSecGroupAssociateImportID(resourceName, "enforce"),
+			},
+		},
+	})
+}
+
+func TestAccNetworkingV2PortSecGroupAssociate_importBasic(t *testing.T) {
+	resourceName := "openstack_networking_port_secgroup_associate_v2.port_1"
+
+	if os.Getenv("TF_ACC") != "" {
+		hiddenPort, err := testAccCheckNetworkingV2PortSecGroupCreatePort(t, "hidden_port", true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer testAccCheckNetworkingV2PortSecGroupDeletePort(t, hiddenPort) //nolint:errcheck
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2PortSecGroupAssociateManifestUpdate0(),
+			},
+
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// all security groups of the port are imported as managed ones
+				ImportStateVerifyIgnore: []string{
+					"security_group_ids",
+				},
+			},
+		},
+	})
+}
+
+func testAccNetworkingV2PortSecGroupAssociateImportID(resourceName, suffix string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		associate, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Port security group association not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", associate.Primary.ID, suffix), nil
+	}
+}
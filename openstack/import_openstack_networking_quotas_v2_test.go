@@ -1,9 +1,11 @@
 package openstack
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 )
 
 func TestAccNetworkingQuotaV2_importBasic(t *testing.T) {
@@ -28,3 +30,49 @@ func TestAccNetworkingQuotaV2_importBasic(t *testing.T) {
 		},
 	})
 }
+
+func TestAccNetworkingQuotaV2_importProjectID(t *testing.T) {
+	resourceName := "openstack_networking_quota_v2.quota_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIdentityV3ProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingQuotaV2Basic,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccQuotaImportID(resourceName, false),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccQuotaImportID(resourceName, true),
+			},
+		},
+	})
+}
+
+func testAccQuotaImportID(n string, withRegion bool) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		projectID := rs.Primary.Attributes["project_id"]
+		if !withRegion {
+			return projectID, nil
+		}
+
+		return fmt.Sprintf("%s/%s", projectID, rs.Primary.Attributes["region"]), nil
+	}
+}
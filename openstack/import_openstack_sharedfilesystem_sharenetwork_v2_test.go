@@ -30,3 +30,28 @@ func TestAccSFSV2ShareNetwork_importBasic(t *testing.T) {
 		},
 	})
 }
+
+func TestAccSFSV2ShareNetwork_importSecService(t *testing.T) {
+	resourceName := "openstack_sharedfilesystem_sharenetwork_v2.sharenetwork_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckSFS(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSFSV2ShareNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSFSV2ShareNetworkConfigSecService2(),
+			},
+
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
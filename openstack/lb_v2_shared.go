@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
@@ -47,24 +48,59 @@ func getLbSkipStatuses() []string {
 	return []string{lbError, lbActive}
 }
 
+// getLbV2SkipStatuses returns the loadbalancer statuses after which a pool or
+// monitor waiter checks the child object itself. Octavia always sets a
+// provisioning_status on the loadbalancer. neutron-lbaas is only reliable
+// through the statuses tree, where drivers that don't track provisioning
+// leave the loadbalancer status empty. Waiting on that would never finish,
+// so it's treated like ACTIVE.
+func getLbV2SkipStatuses(useOctavia bool) []string {
+	if useOctavia {
+		return getLbSkipStatuses()
+	}
+
+	return []string{lbError, lbActive, ""}
+}
+
 // chooseLBV2Client will determine which load balacing client to use:
 // either the Octavia/LBaaS client or the Neutron/Networking v2 client.
 func chooseLBV2Client(d *schema.ResourceData, config *Config) (*gophercloud.ServiceClient, error) {
-	if config.UseOctavia {
-		return config.LoadBalancerV2Client(GetRegion(d, config))
-	}
-	return config.NetworkingV2Client(GetRegion(d, config))
+	region := GetRegion(d, config)
+
+	return selectLBV2Client(config.UseOctavia, region,
+		func() (*gophercloud.ServiceClient, error) {
+			return config.LoadBalancerV2Client(region)
+		},
+		func() (*gophercloud.ServiceClient, error) {
+			return config.NetworkingV2Client(region)
+		},
+	)
 }
 
-// chooseLBV2AccTestClient will determine which load balacing client to use:
-// either the Octavia/LBaaS client or the Neutron/Networking v2 client.
-// This is similar to the chooseLBV2Client function but specific for acceptance
-// tests.
-func chooseLBV2AccTestClient(config *Config, region string) (*gophercloud.ServiceClient, error) {
-	if config.UseOctavia {
-		return config.LoadBalancerV2Client(region)
+// selectLBV2Client returns the Octavia client when useOctavia is set and the
+// Neutron client otherwise. There is no silent fallback between the two
+// services, since the request bodies differ. When the preferred service is
+// missing, the error says whether the other one is available.
+func selectLBV2Client(useOctavia bool, region string, octavia, neutron func() (*gophercloud.ServiceClient, error)) (*gophercloud.ServiceClient, error) {
+	preferred, other := neutron, octavia
+	if useOctavia {
+		preferred, other = octavia, neutron
 	}
-	return config.NetworkingV2Client(region)
+
+	client, err := preferred()
+	if err == nil {
+		return client, nil
+	}
+
+	if _, otherErr := other(); otherErr != nil {
+		return nil, fmt.Errorf("Neither the %s nor the network service is available in region %q: %s", octaviaLBClientType, region, err)
+	}
+
+	if useOctavia {
+		return nil, fmt.Errorf("use_octavia is set but the %s service is not available in region %q, unset use_octavia to use neutron-lbaas: %s", octaviaLBClientType, region, err)
+	}
+
+	return nil, fmt.Errorf("The network service is not available in region %q, set use_octavia to use the %s service: %s", region, octaviaLBClientType, err)
 }
 
 // chooseLBV2ListenerCreateOpts will determine which load balancer listener Create options to use:
@@ -139,7 +175,29 @@ func chooseLBV2ListenerCreateOpts(d *schema.ResourceData, config *Config) (neutr
 			opts.AllowedCIDRs = allowedCidrs
 		}
 
-		createOpts = opts
+		opts.TLSVersions = expandLBV2ListenerTLSVersions(d.Get("tls_versions").([]interface{}))
+
+		tlsOpts := ListenerTLSCreateOptsExt{
+			CreateOptsBuilder:       opts,
+			ALPNProtocols:           expandToStringSlice(d.Get("alpn_protocols").([]interface{})),
+			TLSCiphers:              d.Get("tls_ciphers").(string),
+			ClientAuthentication:    d.Get("client_authentication").(string),
+			ClientCATLSContainerRef: d.Get("client_ca_tls_container_ref").(string),
+		}
+
+		if len(tlsOpts.ALPNProtocols) > 0 || tlsOpts.TLSCiphers != "" || len(opts.TLSVersions) > 0 ||
+			tlsOpts.ClientCATLSContainerRef != "" {
+			if protocol := d.Get("protocol").(string); protocol != "TERMINATED_HTTPS" {
+				return nil, fmt.Errorf("alpn_protocols, tls_ciphers, tls_versions and client_ca_tls_container_ref can not be set for a %s listener", protocol)
+			}
+		}
+
+		err = checkLBV2ListenerClientAuthentication(tlsOpts.ClientAuthentication, tlsOpts.ClientCATLSContainerRef)
+		if err != nil {
+			return nil, err
+		}
+
+		createOpts = tlsOpts
 
 		return createOpts, nil
 	}
@@ -271,8 +329,50 @@ func chooseLBV2ListenerUpdateOpts(d *schema.ResourceData, config *Config) (neutr
 			opts.AllowedCIDRs = &allowedCidrs
 		}
 
+		if d.HasChange("tls_versions") {
+			hasChange = true
+			tlsVersions := expandLBV2ListenerTLSVersions(d.Get("tls_versions").([]interface{}))
+			opts.TLSVersions = &tlsVersions
+		}
+
+		tlsOpts := ListenerTLSUpdateOptsExt{
+			UpdateOptsBuilder: opts,
+		}
+
+		if d.HasChange("alpn_protocols") {
+			hasChange = true
+			alpnProtocols := expandToStringSlice(d.Get("alpn_protocols").([]interface{}))
+			tlsOpts.ALPNProtocols = &alpnProtocols
+		}
+
+		if d.HasChange("tls_ciphers") {
+			hasChange = true
+			tlsCiphers := d.Get("tls_ciphers").(string)
+			tlsOpts.TLSCiphers = &tlsCiphers
+		}
+
+		if d.HasChange("client_authentication") {
+			hasChange = true
+			clientAuthentication := d.Get("client_authentication").(string)
+			tlsOpts.ClientAuthentication = &clientAuthentication
+		}
+
+		if d.HasChange("client_ca_tls_container_ref") {
+			hasChange = true
+			clientCATLSContainerRef := d.Get("client_ca_tls_container_ref").(string)
+			tlsOpts.ClientCATLSContainerRef = &clientCATLSContainerRef
+		}
+
+		if d.HasChange("client_authentication") || d.HasChange("client_ca_tls_container_ref") {
+			err := checkLBV2ListenerClientAuthentication(
+				d.Get("client_authentication").(string), d.Get("client_ca_tls_container_ref").(string))
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		if hasChange {
-			return opts, nil
+			return tlsOpts, nil
 		}
 	}
 
@@ -332,6 +432,55 @@ func chooseLBV2ListenerUpdateOpts(d *schema.ResourceData, config *Config) (neutr
 	return nil, nil
 }
 
+// checkLBV2ListenerClientAuthentication ensures a client CA is provided when
+// the listener verifies client certificates.
+func checkLBV2ListenerClientAuthentication(clientAuthentication, clientCATLSContainerRef string) error {
+	if (clientAuthentication == "OPTIONAL" || clientAuthentication == "MANDATORY") && clientCATLSContainerRef == "" {
+		return fmt.Errorf("client_ca_tls_container_ref is required when client_authentication is set to %s", clientAuthentication)
+	}
+
+	return nil
+}
+
+// suppressLBV2ListenerAllowedCIDRsReorder suppresses the allowed_cidrs diff
+// if the CIDRs have only been reordered.
+func suppressLBV2ListenerAllowedCIDRsReorder(k, old, new string, d *schema.ResourceData) bool {
+	if d.Id() == "" {
+		return false
+	}
+
+	o, n := d.GetChange("allowed_cidrs")
+	oldCIDRs, newCIDRs := o.([]interface{}), n.([]interface{})
+	if len(oldCIDRs) != len(newCIDRs) {
+		return false
+	}
+
+	added, removed := lbV2ListenerAllowedCIDRsDelta(oldCIDRs, newCIDRs)
+
+	return len(added) == 0 && len(removed) == 0
+}
+
+// lbV2ListenerAllowedCIDRsDelta returns the CIDRs which are present only in
+// newCIDRs (added) and only in oldCIDRs (removed), regardless of their order.
+func lbV2ListenerAllowedCIDRsDelta(oldCIDRs, newCIDRs []interface{}) (added, removed []string) {
+	o := expandToStringSlice(oldCIDRs)
+	n := expandToStringSlice(newCIDRs)
+
+	for _, cidr := range n {
+		if !strSliceContains(o, cidr) {
+			added = append(added, cidr)
+		}
+	}
+
+	for _, cidr := range o {
+		if !strSliceContains(n, cidr) {
+			removed = append(removed, cidr)
+		}
+	}
+
+	return added, removed
+}
+
 func expandLBV2ListenerHeadersMap(raw map[string]interface{}) (map[string]string, error) {
 	m := make(map[string]string, len(raw))
 	for key, val := range raw {
@@ -346,6 +495,15 @@ func expandLBV2ListenerHeadersMap(raw map[string]interface{}) (map[string]string
 	return m, nil
 }
 
+func expandLBV2ListenerTLSVersions(raw []interface{}) []octavialisteners.TLSVersion {
+	versions := make([]octavialisteners.TLSVersion, len(raw))
+	for i, v := range raw {
+		versions[i] = octavialisteners.TLSVersion(v.(string))
+	}
+
+	return versions
+}
+
 func waitForLBV2Listener(lbClient *gophercloud.ServiceClient, listener *neutronlisteners.Listener, target string, pending []string, timeout time.Duration) error {
 	log.Printf("[DEBUG] Waiting for openstack_lb_listener_v2 %s to become %s.", listener.ID, target)
 
@@ -670,13 +828,15 @@ func waitForLBV2Monitor(lbClient *gophercloud.ServiceClient, parentPool *neutron
 }
 
 func resourceLBV2MonitorRefreshFunc(lbClient *gophercloud.ServiceClient, lbID string, monitor *neutronmonitors.Monitor) resource.StateRefreshFunc {
-	if monitor.ProvisioningStatus != "" {
+	// neutron-lbaas may return a provisioning_status on the monitor itself,
+	// but only the statuses tree is kept up to date there.
+	if lbClient.Type == octaviaLBClientType {
 		return func() (interface{}, string, error) {
 			lb, status, err := resourceLBV2LoadBalancerRefreshFunc(lbClient, lbID)()
 			if err != nil {
 				return lb, status, err
 			}
-			if !strSliceContains(getLbSkipStatuses(), status) {
+			if !strSliceContains(getLbV2SkipStatuses(true), status) {
 				return lb, status, nil
 			}
 
@@ -723,14 +883,33 @@ func waitForLBV2Pool(lbClient *gophercloud.ServiceClient, pool *neutronpools.Poo
 	return nil
 }
 
+// waitForLBV2PoolLoadBalancer waits for the parent loadbalancer of an Octavia
+// pool rather than the pool itself. Octavia serializes every change on the
+// loadbalancer, so the root object is the one which has to be ACTIVE before
+// a child can be created. neutron-lbaas pools are waited on as before.
+func waitForLBV2PoolLoadBalancer(lbClient *gophercloud.ServiceClient, pool *neutronpools.Pool, timeout time.Duration) error {
+	if lbClient.Type != octaviaLBClientType {
+		return waitForLBV2Pool(lbClient, pool, "ACTIVE", getLbPendingStatuses(), timeout)
+	}
+
+	lbID, err := lbV2FindLBIDviaPool(lbClient, pool)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Waiting for loadbalancer %s of pool %s to become ACTIVE.", lbID, pool.ID)
+
+	return waitForLBV2LoadBalancer(lbClient, lbID, "ACTIVE", getLbPendingStatuses(), timeout)
+}
+
 func resourceLBV2PoolRefreshFunc(lbClient *gophercloud.ServiceClient, lbID string, pool *neutronpools.Pool) resource.StateRefreshFunc {
-	if pool.ProvisioningStatus != "" {
+	if lbClient.Type == octaviaLBClientType {
 		return func() (interface{}, string, error) {
 			lb, status, err := resourceLBV2LoadBalancerRefreshFunc(lbClient, lbID)()
 			if err != nil {
 				return lb, status, err
 			}
-			if !strSliceContains(getLbSkipStatuses(), status) {
+			if !strSliceContains(getLbV2SkipStatuses(true), status) {
 				return lb, status, nil
 			}
 
@@ -786,7 +965,7 @@ func resourceLBV2LoadBalancerStatusRefreshFuncNeutron(lbClient *gophercloud.Serv
 		if statuses == nil || statuses.Loadbalancer == nil {
 			statuses = new(neutronloadbalancers.StatusTree)
 			statuses.Loadbalancer = new(neutronloadbalancers.LoadBalancer)
-		} else if !strSliceContains(getLbSkipStatuses(), statuses.Loadbalancer.ProvisioningStatus) {
+		} else if !strSliceContains(getLbV2SkipStatuses(false), statuses.Loadbalancer.ProvisioningStatus) {
 			return statuses.Loadbalancer, statuses.Loadbalancer.ProvisioningStatus, nil
 		}
 
@@ -1009,15 +1188,187 @@ func waitForLBV2L7Rule(lbClient *gophercloud.ServiceClient, parentListener *neut
 	return nil
 }
 
-func flattenLBPoolPersistenceV2(p neutronpools.SessionPersistence) []map[string]interface{} {
+// expandLBPoolPersistenceV2 returns the session persistence of a pool, or
+// an empty PoolSessionPersistence when persistence is not set.
+func expandLBPoolPersistenceV2(raw []interface{}) (*PoolSessionPersistence, error) {
+	var persistence PoolSessionPersistence
+	if len(raw) == 0 || raw[0] == nil {
+		return &persistence, nil
+	}
+
+	pV := raw[0].(map[string]interface{})
+	persistence.Type = pV["type"].(string)
+	persistence.PersistenceTimeout = pV["persistence_timeout"].(int)
+
+	if persistence.Type == "APP_COOKIE" {
+		if pV["cookie_name"].(string) == "" {
+			return nil, fmt.Errorf(
+				"Persistence cookie_name needs to be set if using 'APP_COOKIE' persistence type")
+		}
+		persistence.CookieName = pV["cookie_name"].(string)
+	} else {
+		if pV["cookie_name"].(string) != "" {
+			return nil, fmt.Errorf(
+				"Persistence cookie_name can only be set if using 'APP_COOKIE' persistence type")
+		}
+	}
+
+	return &persistence, nil
+}
+
+func flattenLBPoolPersistenceV2(p PoolSessionPersistence) []map[string]interface{} {
+	if p.Type == "" {
+		return nil
+	}
+
 	return []map[string]interface{}{
 		{
-			"type":        p.Type,
-			"cookie_name": p.CookieName,
+			"type":                p.Type,
+			"cookie_name":         p.CookieName,
+			"persistence_timeout": p.PersistenceTimeout,
 		},
 	}
 }
 
+// checkLBV2MemberBackup ensures backup members are only used with a pool
+// algorithm which supports them.
+func checkLBV2MemberBackup(lbMethod string, backup bool) error {
+	if backup && lbMethod == "SOURCE_IP_PORT" {
+		return fmt.Errorf("backup members are not supported with the %s lb_method", lbMethod)
+	}
+
+	return nil
+}
+
+// checkLBV2MemberOctaviaOnly returns an error when the Octavia only member
+// attributes are set without Octavia.
+func checkLBV2MemberOctaviaOnly(d *schema.ResourceData) error {
+	_, backup := d.GetOkExists("backup")
+	_, monitorAddress := d.GetOk("monitor_address")
+	_, monitorPort := d.GetOk("monitor_port")
+	if backup || monitorAddress || monitorPort {
+		return fmt.Errorf("backup, monitor_address and monitor_port are only supported by Octavia")
+	}
+
+	return nil
+}
+
+// lbV2MemberResource returns the schema of a member managed through the
+// Octavia batch member update API.
+func lbV2MemberResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"address": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"protocol_port": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 65535),
+			},
+
+			"weight": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(0, 256),
+			},
+
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"backup": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"admin_state_up": {
+				Type:     schema.TypeBool,
+				Default:  true,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func expandLBV2LoadBalancerAdditionalVIPs(raw []interface{}) []LoadBalancerAdditionalVIP {
+	vips := make([]LoadBalancerAdditionalVIP, len(raw))
+	for i, v := range raw {
+		vip := v.(map[string]interface{})
+		vips[i] = LoadBalancerAdditionalVIP{
+			SubnetID:  vip["subnet_id"].(string),
+			IPAddress: vip["ip_address"].(string),
+		}
+	}
+
+	return vips
+}
+
+func flattenLBV2LoadBalancerAdditionalVIPs(vips []LoadBalancerAdditionalVIP) []map[string]interface{} {
+	m := make([]map[string]interface{}, len(vips))
+	for i, vip := range vips {
+		m[i] = map[string]interface{}{
+			"subnet_id":  vip.SubnetID,
+			"ip_address": vip.IPAddress,
+		}
+	}
+
+	return m
+}
+
+// checkLBV2PoolMemberOctavia returns an error when the inline pool members
+// can't be managed, since they rely on the Octavia batch member update.
+func checkLBV2PoolMemberOctavia(lbClient *gophercloud.ServiceClient) error {
+	if lbClient.Type != octaviaLBClientType {
+		return fmt.Errorf("member is only supported by Octavia, set use_octavia to true")
+	}
+
+	return nil
+}
+
+// batchUpdateLBV2PoolMembers replaces all members of the pool in a single
+// Octavia batch member update and waits for the pool to become active again.
+func batchUpdateLBV2PoolMembers(lbClient *gophercloud.ServiceClient, pool *neutronpools.Pool, members []octaviapools.BatchUpdateMemberOpts, timeout time.Duration) error {
+	err := waitForLBV2Pool(lbClient, pool, "ACTIVE", getLbPendingStatuses(), timeout)
+	if err != nil {
+		return err
+	}
+
+	// An empty list removes all members.
+	if members == nil {
+		members = []octaviapools.BatchUpdateMemberOpts{}
+	}
+
+	log.Printf("[DEBUG] Updating %s pool members with options: %#v", pool.ID, members)
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		err = octaviapools.BatchUpdateMembers(lbClient, pool.ID, members).ExtractErr()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("Unable to update members of pool %s: %s", pool.ID, err)
+	}
+
+	return waitForLBV2Pool(lbClient, pool, "ACTIVE", getLbPendingStatuses(), timeout)
+}
+
 func flattenLBMembersV2(members []octaviapools.Member) []map[string]interface{} {
 	m := make([]map[string]interface{}, len(members))
 
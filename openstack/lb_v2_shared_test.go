@@ -1,11 +1,31 @@
 package openstack
 
 import (
+	"fmt"
 	"testing"
 
+	"github.com/gophercloud/gophercloud"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
 	"github.com/stretchr/testify/assert"
 )
 
+// chooseLBV2AccTestClient will determine which load balacing client to use:
+// either the Octavia/LBaaS client or the Neutron/Networking v2 client.
+// This is similar to the chooseLBV2Client function but specific for acceptance
+// tests.
+func chooseLBV2AccTestClient(config *Config, region string) (*gophercloud.ServiceClient, error) {
+	return selectLBV2Client(config.UseOctavia, region,
+		func() (*gophercloud.ServiceClient, error) {
+			return config.LoadBalancerV2Client(region)
+		},
+		func() (*gophercloud.ServiceClient, error) {
+			return config.NetworkingV2Client(region)
+		},
+	)
+}
+
 func TestExpandLBV2ListenerHeadersMap(t *testing.T) {
 	raw := map[string]interface{}{
 		"header0": "val0",
@@ -34,3 +54,182 @@ func TestExpandLBV2ListenerHeadersMap_err(t *testing.T) {
 	assert.Error(t, err)
 	assert.Empty(t, actual)
 }
+
+func TestLBV2ListenerAllowedCIDRsDelta(t *testing.T) {
+	oldCIDRs := []interface{}{"10.0.0.0/8", "192.168.0.0/24"}
+
+	added, removed := lbV2ListenerAllowedCIDRsDelta(oldCIDRs, []interface{}{"192.168.0.0/24", "10.0.0.0/8"})
+	assert.Empty(t, added)
+	assert.Empty(t, removed)
+
+	added, removed = lbV2ListenerAllowedCIDRsDelta(oldCIDRs, []interface{}{"192.168.0.0/24", "172.16.0.0/12"})
+	assert.Equal(t, []string{"172.16.0.0/12"}, added)
+	assert.Equal(t, []string{"10.0.0.0/8"}, removed)
+}
+
+func TestCheckLBV2ListenerClientAuthentication(t *testing.T) {
+	assert.NoError(t, checkLBV2ListenerClientAuthentication("", ""))
+	assert.NoError(t, checkLBV2ListenerClientAuthentication("NONE", ""))
+	assert.NoError(t, checkLBV2ListenerClientAuthentication("MANDATORY", "http://barbican/v1/containers/ca"))
+	assert.Error(t, checkLBV2ListenerClientAuthentication("OPTIONAL", ""))
+	assert.Error(t, checkLBV2ListenerClientAuthentication("MANDATORY", ""))
+}
+
+func TestExpandLBPoolPersistenceV2(t *testing.T) {
+	actual, err := expandLBPoolPersistenceV2(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, &PoolSessionPersistence{}, actual)
+
+	raw := []interface{}{
+		map[string]interface{}{
+			"type":                "APP_COOKIE",
+			"cookie_name":         "testCookie",
+			"persistence_timeout": 0,
+		},
+	}
+
+	actual, err = expandLBPoolPersistenceV2(raw)
+	assert.NoError(t, err)
+	assert.Equal(t, &PoolSessionPersistence{Type: "APP_COOKIE", CookieName: "testCookie"}, actual)
+}
+
+func TestExpandLBPoolPersistenceV2_err(t *testing.T) {
+	_, err := expandLBPoolPersistenceV2([]interface{}{
+		map[string]interface{}{
+			"type":                "APP_COOKIE",
+			"cookie_name":         "",
+			"persistence_timeout": 0,
+		},
+	})
+	assert.Error(t, err)
+
+	_, err = expandLBPoolPersistenceV2([]interface{}{
+		map[string]interface{}{
+			"type":                "SOURCE_IP",
+			"cookie_name":         "testCookie",
+			"persistence_timeout": 0,
+		},
+	})
+	assert.Error(t, err)
+}
+
+func TestFlattenLBPoolPersistenceV2(t *testing.T) {
+	assert.Empty(t, flattenLBPoolPersistenceV2(PoolSessionPersistence{}))
+
+	expected := []map[string]interface{}{
+		{
+			"type":                "SOURCE_IP",
+			"cookie_name":         "",
+			"persistence_timeout": 60,
+		},
+	}
+
+	actual := flattenLBPoolPersistenceV2(PoolSessionPersistence{Type: "SOURCE_IP", PersistenceTimeout: 60})
+	assert.Equal(t, expected, actual)
+}
+
+func TestCheckLBV2MemberBackup(t *testing.T) {
+	assert.NoError(t, checkLBV2MemberBackup("ROUND_ROBIN", true))
+	assert.NoError(t, checkLBV2MemberBackup("SOURCE_IP_PORT", false))
+	assert.Error(t, checkLBV2MemberBackup("SOURCE_IP_PORT", true))
+}
+
+func TestCheckLBV2MemberOctaviaOnly(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceMemberV2().Schema, map[string]interface{}{
+		"address":       "192.168.199.10",
+		"protocol_port": 8080,
+	})
+	assert.NoError(t, checkLBV2MemberOctaviaOnly(d))
+
+	d = schema.TestResourceDataRaw(t, resourceMemberV2().Schema, map[string]interface{}{
+		"address":       "192.168.199.10",
+		"protocol_port": 8080,
+		"monitor_port":  8081,
+	})
+	assert.Error(t, checkLBV2MemberOctaviaOnly(d))
+}
+
+func TestCheckLBV2PoolMemberOctavia(t *testing.T) {
+	assert.NoError(t, checkLBV2PoolMemberOctavia(&gophercloud.ServiceClient{Type: octaviaLBClientType}))
+	assert.Error(t, checkLBV2PoolMemberOctavia(&gophercloud.ServiceClient{Type: "network"}))
+}
+
+func TestSelectLBV2Client(t *testing.T) {
+	octavia := func() (*gophercloud.ServiceClient, error) {
+		return &gophercloud.ServiceClient{Type: octaviaLBClientType}, nil
+	}
+	neutron := func() (*gophercloud.ServiceClient, error) {
+		return &gophercloud.ServiceClient{Type: "network"}, nil
+	}
+	missing := func() (*gophercloud.ServiceClient, error) {
+		return nil, fmt.Errorf("No suitable endpoint could be found in the service catalog.")
+	}
+
+	client, err := selectLBV2Client(true, "RegionOne", octavia, neutron)
+	assert.NoError(t, err)
+	assert.Equal(t, octaviaLBClientType, client.Type)
+
+	client, err = selectLBV2Client(false, "RegionOne", octavia, neutron)
+	assert.NoError(t, err)
+	assert.Equal(t, "network", client.Type)
+
+	client, err = selectLBV2Client(false, "RegionOne", missing, neutron)
+	assert.NoError(t, err)
+	assert.Equal(t, "network", client.Type)
+
+	_, err = selectLBV2Client(true, "RegionOne", missing, neutron)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unset use_octavia")
+
+	_, err = selectLBV2Client(false, "RegionOne", octavia, missing)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "set use_octavia")
+
+	_, err = selectLBV2Client(true, "RegionOne", missing, missing)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Neither")
+}
+
+func TestGetLbV2SkipStatuses(t *testing.T) {
+	assert.Equal(t, []string{"ERROR", "ACTIVE"}, getLbV2SkipStatuses(true))
+	assert.Equal(t, []string{"ERROR", "ACTIVE", ""}, getLbV2SkipStatuses(false))
+}
+
+func TestResourceListenerV2DiffAllowedCIDRs(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+		Attributes: map[string]string{
+			"id":              "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+			"protocol":        "HTTP",
+			"protocol_port":   "8080",
+			"loadbalancer_id": "ad4ed6e4-4f8f-4ff8-9bb1-a4d8e4d0e7a5",
+			"allowed_cidrs.#": "2",
+			"allowed_cidrs.0": "10.0.0.0/8",
+			"allowed_cidrs.1": "192.168.0.0/24",
+		},
+	}
+
+	config := func(cidrs ...interface{}) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"protocol":        "HTTP",
+			"protocol_port":   8080,
+			"loadbalancer_id": "ad4ed6e4-4f8f-4ff8-9bb1-a4d8e4d0e7a5",
+			"allowed_cidrs":   cidrs,
+		})
+	}
+
+	diff, err := resourceListenerV2().Diff(state, config("192.168.0.0/24", "10.0.0.0/8"), &Config{})
+	assert.NoError(t, err)
+	if diff != nil {
+		for k := range diff.Attributes {
+			assert.NotContains(t, k, "allowed_cidrs")
+		}
+	}
+
+	diff, err = resourceListenerV2().Diff(state, config("192.168.0.0/24", "172.16.0.0/12"), &Config{})
+	assert.NoError(t, err)
+	if assert.NotNil(t, diff) {
+		assert.Contains(t, diff.Attributes, "allowed_cidrs.0")
+		assert.Contains(t, diff.Attributes, "allowed_cidrs.1")
+	}
+}
@@ -0,0 +1,71 @@
+package openstack
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions"
+)
+
+// networkingAvailabilityZoneV2 represents a Neutron availability zone.
+type networkingAvailabilityZoneV2 struct {
+	Name     string `json:"name"`
+	Resource string `json:"resource"`
+	State    string `json:"state"`
+}
+
+// networkingAvailabilityZonesV2Available checks whether the Neutron
+// availability_zone extension is available.
+func networkingAvailabilityZonesV2Available(client *gophercloud.ServiceClient) (bool, error) {
+	_, err := extensions.Get(client, "availability_zone").Extract()
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); ok {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("Error checking the availability_zone extension: %s", err)
+	}
+
+	return true, nil
+}
+
+// networkingAvailabilityZonesV2List retrieves the Neutron availability zones.
+func networkingAvailabilityZonesV2List(client *gophercloud.ServiceClient) ([]networkingAvailabilityZoneV2, error) {
+	var r gophercloud.Result
+	_, r.Err = client.Get(client.ServiceURL("availability_zones"), &r.Body, nil)
+
+	var s struct {
+		AvailabilityZones []networkingAvailabilityZoneV2 `json:"availability_zones"`
+	}
+	err := r.ExtractInto(&s)
+
+	return s.AvailabilityZones, err
+}
+
+// filterNetworkingAvailabilityZonesV2 returns the sorted, unique names of the
+// zones matching state and, when set, resource.
+func filterNetworkingAvailabilityZonesV2(zones []networkingAvailabilityZoneV2, state, resource string) []string {
+	seen := make(map[string]bool)
+	names := []string{}
+
+	for _, z := range zones {
+		if !strings.EqualFold(z.State, state) {
+			continue
+		}
+		if resource != "" && z.Resource != resource {
+			continue
+		}
+		if seen[z.Name] {
+			continue
+		}
+
+		seen[z.Name] = true
+		names = append(names, z.Name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
@@ -0,0 +1,21 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterNetworkingAvailabilityZonesV2(t *testing.T) {
+	zones := []networkingAvailabilityZoneV2{
+		{Name: "zone-b", Resource: "network", State: "available"},
+		{Name: "zone-b", Resource: "router", State: "available"},
+		{Name: "zone-a", Resource: "router", State: "available"},
+		{Name: "zone-c", Resource: "network", State: "unavailable"},
+	}
+
+	assert.Equal(t, []string{"zone-a", "zone-b"}, filterNetworkingAvailabilityZonesV2(zones, "available", ""))
+	assert.Equal(t, []string{"zone-b"}, filterNetworkingAvailabilityZonesV2(zones, "available", "network"))
+	assert.Equal(t, []string{"zone-c"}, filterNetworkingAvailabilityZonesV2(zones, "unavailable", ""))
+	assert.Equal(t, []string{}, filterNetworkingAvailabilityZonesV2(zones, "unavailable", "router"))
+}
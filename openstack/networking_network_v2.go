@@ -2,6 +2,7 @@ package openstack
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/dns"
@@ -124,3 +125,36 @@ func expandNetworkingNetworkSegmentsV2(segments *schema.Set) []provider.Segment
 
 	return providerSegments
 }
+
+// flattenNetworkingNetworkSegmentsV2 flattens the provider attributes of a
+// network. A network with a single segment doesn't return the segments list,
+// but the provider attributes on the network itself. Both are only visible
+// to admins.
+func flattenNetworkingNetworkSegmentsV2(network provider.NetworkProviderExt) []map[string]interface{} {
+	if len(network.Segments) > 0 {
+		segments := make([]map[string]interface{}, len(network.Segments))
+		for i, segment := range network.Segments {
+			segments[i] = map[string]interface{}{
+				"physical_network": segment.PhysicalNetwork,
+				"network_type":     segment.NetworkType,
+				"segmentation_id":  segment.SegmentationID,
+			}
+		}
+
+		return segments
+	}
+
+	if network.NetworkType == "" {
+		return nil
+	}
+
+	segmentationID, _ := strconv.Atoi(network.SegmentationID)
+
+	return []map[string]interface{}{
+		{
+			"physical_network": network.PhysicalNetwork,
+			"network_type":     network.NetworkType,
+			"segmentation_id":  segmentationID,
+		},
+	}
+}
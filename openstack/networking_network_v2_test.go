@@ -1,6 +1,7 @@
 package openstack
 
 import (
+	"net/url"
 	"testing"
 
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/provider"
@@ -43,3 +44,71 @@ func TestExpandNetworkingNetworkSegmentsV2(t *testing.T) {
 
 	assert.ElementsMatch(t, expectedSegments, actualSegments)
 }
+
+func TestDataSourceNetworkingNetworkV2ListOpts(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, dataSourceNetworkingNetworkV2().Schema, map[string]interface{}{
+		"name":     "public",
+		"external": true,
+		"shared":   "false",
+		"tags":     []interface{}{"foo"},
+	})
+
+	query, err := dataSourceNetworkingNetworkV2ListOpts(d).ToNetworkListQuery()
+	assert.NoError(t, err)
+
+	u, err := url.Parse(query)
+	assert.NoError(t, err)
+
+	values := u.Query()
+	assert.Equal(t, "public", values.Get("name"))
+	assert.Equal(t, "true", values.Get("router:external"))
+	assert.Equal(t, "false", values.Get("shared"))
+	assert.Equal(t, "foo", values.Get("tags"))
+}
+
+func TestFlattenNetworkingNetworkSegmentsV2(t *testing.T) {
+	network := provider.NetworkProviderExt{
+		Segments: []provider.Segment{
+			{
+				PhysicalNetwork: "physnet1",
+				NetworkType:     "vlan",
+				SegmentationID:  1001,
+			},
+			{
+				NetworkType:    "vxlan",
+				SegmentationID: 2,
+			},
+		},
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"physical_network": "physnet1",
+			"network_type":     "vlan",
+			"segmentation_id":  1001,
+		},
+		{
+			"physical_network": "",
+			"network_type":     "vxlan",
+			"segmentation_id":  2,
+		},
+	}
+	assert.Equal(t, expected, flattenNetworkingNetworkSegmentsV2(network))
+
+	network = provider.NetworkProviderExt{
+		PhysicalNetwork: "physnet1",
+		NetworkType:     "vlan",
+		SegmentationID:  "1001",
+	}
+
+	expected = []map[string]interface{}{
+		{
+			"physical_network": "physnet1",
+			"network_type":     "vlan",
+			"segmentation_id":  1001,
+		},
+	}
+	assert.Equal(t, expected, flattenNetworkingNetworkSegmentsV2(network))
+
+	assert.Nil(t, flattenNetworkingNetworkSegmentsV2(provider.NetworkProviderExt{}))
+}
@@ -5,8 +5,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"net"
+	"strings"
+	"time"
 
 	"github.com/gophercloud/gophercloud"
+	tokens3 "github.com/gophercloud/gophercloud/openstack/identity/v3/tokens"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/dns"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/extradhcpopts"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/portsbinding"
@@ -16,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 )
 
 type portExtended struct {
@@ -25,6 +31,248 @@ type portExtended struct {
 	portsbinding.PortsBindingExt
 	dns.PortDNSExt
 	policies.QoSPolicyExt
+	PortDeviceProfileExt
+	PortHintsExt
+	PortMACLearningExt
+	PortNUMAAffinityPolicyExt
+}
+
+// networkingPortV2ReservedDeviceOwner is the device_owner set on ports, which
+// are created only to reserve an IP address.
+const networkingPortV2ReservedDeviceOwner = "reserved"
+
+// networkingPortV2VNICTypes is a list of the port binding vnic_type values
+// known by Neutron.
+var networkingPortV2VNICTypes = []string{
+	"normal",
+	"direct",
+	"direct-physical",
+	"macvtap",
+	"baremetal",
+	"virtio-forwarder",
+	"smart-nic",
+	"remote-managed",
+	"accelerator-direct",
+	"accelerator-direct-physical",
+}
+
+func validateNetworkingPortV2VNICType(v interface{}, k string) ([]string, []error) {
+	return validation.StringInSlice(networkingPortV2VNICTypes, true)(v, k)
+}
+
+// networkingPortV2VNICTypeCustomizeDiff validates the port binding vnic_type,
+// unless custom vnic_type values are allowed in the provider configuration.
+func networkingPortV2VNICTypeCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if config, ok := meta.(*Config); ok && config.AllowCustomVNICTypes {
+		return nil
+	}
+
+	vnicType, ok := diff.Get("binding.0.vnic_type").(string)
+	if !ok || vnicType == "" {
+		return nil
+	}
+
+	_, errs := validateNetworkingPortV2VNICType(vnicType, "binding.0.vnic_type")
+	if len(errs) > 0 {
+		return fmt.Errorf("%s. Set allow_custom_vnic_types in the provider configuration to use a custom vnic_type", errs[0])
+	}
+
+	return nil
+}
+
+// networkingPortV2NUMAAffinityPolicies is a list of the port
+// numa_affinity_policy values known by Neutron.
+var networkingPortV2NUMAAffinityPolicies = []string{
+	"required",
+	"preferred",
+	"legacy",
+	"socket",
+}
+
+// networkingPortV2DirectVNICTypes is a list of the port binding vnic_type
+// values of ports, which are directly attached to a device of the host.
+var networkingPortV2DirectVNICTypes = []string{
+	"direct",
+	"direct-physical",
+	"macvtap",
+	"virtio-forwarder",
+	"smart-nic",
+	"remote-managed",
+	"accelerator-direct",
+	"accelerator-direct-physical",
+}
+
+// networkingPortV2NUMAAffinityPolicyCustomizeDiff makes sure that a
+// numa_affinity_policy is only set on direct ports.
+func networkingPortV2NUMAAffinityPolicyCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	policy := diff.Get("numa_affinity_policy").(string)
+	vnicType, _ := diff.Get("binding.0.vnic_type").(string)
+
+	return validateNetworkingPortV2NUMAAffinityPolicyVNICType(policy, vnicType)
+}
+
+func validateNetworkingPortV2NUMAAffinityPolicyVNICType(policy, vnicType string) error {
+	if policy == "" {
+		return nil
+	}
+
+	for _, v := range networkingPortV2DirectVNICTypes {
+		if strings.EqualFold(v, vnicType) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("numa_affinity_policy can only be set on direct ports, e.g. with a binding.0.vnic_type of \"direct\", got %q", vnicType)
+}
+
+// networkingPortV2CheckNUMAAffinityPolicy checks whether the Neutron
+// extensions needed for a numa_affinity_policy are available, because
+// Neutron silently ignores unknown port attributes.
+func networkingPortV2CheckNUMAAffinityPolicy(client *gophercloud.ServiceClient, policy string) error {
+	aliases := []string{"port-numa-affinity-policy"}
+	if policy == "socket" {
+		aliases = append(aliases, "port-numa-affinity-policy-socket")
+	}
+
+	for _, alias := range aliases {
+		_, err := extensions.Get(client, alias).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return fmt.Errorf("numa_affinity_policy %q requires the %s extension, which isn't available in this cloud", policy, alias)
+			}
+
+			return fmt.Errorf("Error checking the %s extension: %s", alias, err)
+		}
+	}
+
+	return nil
+}
+
+// validateNetworkingPortV2Hints validates the port hints. Hints are a JSON
+// object keyed by the backend they apply to, e.g. "openvswitch".
+func validateNetworkingPortV2Hints(v interface{}, k string) ([]string, []error) {
+	ws, errs := validateJSONObject(v, k)
+	if len(errs) > 0 {
+		return ws, errs
+	}
+
+	var hints map[string]interface{}
+	if err := json.Unmarshal([]byte(v.(string)), &hints); err != nil {
+		return nil, []error{fmt.Errorf("%q must be a JSON object: %s", k, err)}
+	}
+
+	for backend, hint := range hints {
+		if _, ok := hint.(map[string]interface{}); !ok {
+			errs = append(errs, fmt.Errorf("%q hints for %q must be a JSON object", k, backend))
+		}
+	}
+
+	return ws, errs
+}
+
+func expandNetworkingPortHintsV2(rawHints string) (map[string]interface{}, error) {
+	if rawHints == "" {
+		return nil, nil
+	}
+
+	var hints map[string]interface{}
+	if err := json.Unmarshal([]byte(rawHints), &hints); err != nil {
+		return nil, fmt.Errorf("Failed to unmarshal the JSON: %s", err)
+	}
+
+	return hints, nil
+}
+
+func flattenNetworkingPortHintsV2(hints map[string]interface{}) string {
+	if len(hints) == 0 {
+		return ""
+	}
+
+	v, err := json.Marshal(hints)
+	if err != nil {
+		log.Printf("[DEBUG] flattenNetworkingPortHintsV2: Cannot marshal hints: %s", err)
+		return ""
+	}
+
+	return string(v)
+}
+
+// networkingPortV2AdminOnlyFields returns the names of the admin-only port
+// attributes, which are set. Neutron allows non-admin users to set any
+// device_owner except the ones reserved for Neutron itself.
+func networkingPortV2AdminOnlyFields(hostID, profile, deviceOwner string) []string {
+	var fields []string
+
+	if hostID != "" {
+		fields = append(fields, "binding.host_id")
+	}
+
+	if profile != "" {
+		fields = append(fields, "binding.profile")
+	}
+
+	if strings.HasPrefix(deviceOwner, "network:") {
+		fields = append(fields, "device_owner")
+	}
+
+	return fields
+}
+
+// networkingPortV2CheckAdminOnlyFields returns an error naming the admin-only
+// port attributes, when they are set by a token without the admin role.
+func networkingPortV2CheckAdminOnlyFields(fields []string, roles []tokens3.Role) error {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	for _, role := range roles {
+		if strings.EqualFold(role.Name, "admin") {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%s can only be set with the admin role, which the current token doesn't have", strings.Join(fields, ", "))
+}
+
+// networkingPortV2TokenRoles returns the roles of the current token. The
+// roles are only known, when the provider authenticated with Keystone v3.
+func networkingPortV2TokenRoles(client *gophercloud.ServiceClient) ([]tokens3.Role, bool) {
+	var (
+		roles []tokens3.Role
+		err   error
+	)
+
+	switch result := client.ProviderClient.GetAuthResult().(type) {
+	case tokens3.CreateResult:
+		roles, err = result.ExtractRoles()
+	case tokens3.GetResult:
+		roles, err = result.ExtractRoles()
+	default:
+		return nil, false
+	}
+
+	if err != nil {
+		log.Printf("[DEBUG] Unable to extract the token roles: %s", err)
+		return nil, false
+	}
+
+	return roles, true
+}
+
+// networkingPortV2Preflight fails early with a clear error, when admin-only
+// port attributes are set by a non-admin token. Otherwise Neutron would
+// respond with an opaque 403.
+func networkingPortV2Preflight(client *gophercloud.ServiceClient, fields []string) error {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	roles, ok := networkingPortV2TokenRoles(client)
+	if !ok {
+		return nil
+	}
+
+	return networkingPortV2CheckAdminOnlyFields(fields, roles)
 }
 
 func resourceNetworkingPortV2StateRefreshFunc(client *gophercloud.ServiceClient, portID string) resource.StateRefreshFunc {
@@ -42,6 +290,33 @@ func resourceNetworkingPortV2StateRefreshFunc(client *gophercloud.ServiceClient,
 	}
 }
 
+// networkingPortV2WaitForStatus waits for the port to reach the given status,
+// e.g. ACTIVE once it's bound and plugged.
+func networkingPortV2WaitForStatus(client *gophercloud.ServiceClient, portID, status string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Waiting for openstack_networking_port_v2 %s to become %s.", portID, status)
+
+	pending := []string{"ACTIVE", "DOWN", "BUILD"}
+	for i, v := range pending {
+		if v == status {
+			pending = append(pending[:i], pending[i+1:]...)
+			break
+		}
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    pending,
+		Target:     []string{status},
+		Refresh:    resourceNetworkingPortV2StateRefreshFunc(client, portID),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
 func expandNetworkingPortDHCPOptsV2Create(dhcpOpts *schema.Set) []extradhcpopts.CreateExtraDHCPOpt {
 	var extraDHCPOpts []extradhcpopts.CreateExtraDHCPOpt
 
@@ -64,9 +339,15 @@ func expandNetworkingPortDHCPOptsV2Create(dhcpOpts *schema.Set) []extradhcpopts.
 	return extraDHCPOpts
 }
 
+// networkingPortV2DHCPOptKey returns the key, which identifies an extra DHCP
+// option. Neutron allows the same option name for different IP versions.
+func networkingPortV2DHCPOptKey(optName string, ipVersion int) string {
+	return fmt.Sprintf("%s/%d", optName, ipVersion)
+}
+
 func expandNetworkingPortDHCPOptsV2Update(oldDHCPopts, newDHCPopts *schema.Set) []extradhcpopts.UpdateExtraDHCPOpt {
 	var extraDHCPOpts []extradhcpopts.UpdateExtraDHCPOpt
-	var newOptNames []string
+	var newOptKeys []string
 
 	if newDHCPopts != nil {
 		for _, raw := range newDHCPopts.List() {
@@ -75,8 +356,8 @@ func expandNetworkingPortDHCPOptsV2Update(oldDHCPopts, newDHCPopts *schema.Set)
 			ipVersion := rawMap["ip_version"].(int)
 			optName := rawMap["name"].(string)
 			optValue := rawMap["value"].(string)
-			// DHCP option name is the primary key, we will check this key below
-			newOptNames = append(newOptNames, optName)
+			// DHCP option name and IP version are the primary key, we will check this key below
+			newOptKeys = append(newOptKeys, networkingPortV2DHCPOptKey(optName, ipVersion))
 
 			extraDHCPOpts = append(extraDHCPOpts, extradhcpopts.UpdateExtraDHCPOpt{
 				OptName:   optName,
@@ -90,13 +371,15 @@ func expandNetworkingPortDHCPOptsV2Update(oldDHCPopts, newDHCPopts *schema.Set)
 		for _, raw := range oldDHCPopts.List() {
 			rawMap := raw.(map[string]interface{})
 
+			ipVersion := rawMap["ip_version"].(int)
 			optName := rawMap["name"].(string)
 
-			// if we already add a new option with the same name, it means that we update it, no need to delete
-			if !strSliceContains(newOptNames, optName) {
+			// if we already add a new option with the same key, it means that we update it, no need to delete
+			if !strSliceContains(newOptKeys, networkingPortV2DHCPOptKey(optName, ipVersion)) {
 				extraDHCPOpts = append(extraDHCPOpts, extradhcpopts.UpdateExtraDHCPOpt{
-					OptName:  optName,
-					OptValue: nil,
+					OptName:   optName,
+					OptValue:  nil,
+					IPVersion: gophercloud.IPVersion(ipVersion),
 				})
 			}
 		}
@@ -109,8 +392,15 @@ func flattenNetworkingPortDHCPOptsV2(dhcpOpts extradhcpopts.ExtraDHCPOptsExt) []
 	dhcpOptsSet := make([]map[string]interface{}, len(dhcpOpts.ExtraDHCPOpts))
 
 	for i, dhcpOpt := range dhcpOpts.ExtraDHCPOpts {
+		// Older Neutron versions don't return the IP version of an option,
+		// which is IPv4 by default.
+		ipVersion := dhcpOpt.IPVersion
+		if ipVersion == 0 {
+			ipVersion = 4
+		}
+
 		dhcpOptsSet[i] = map[string]interface{}{
-			"ip_version": dhcpOpt.IPVersion,
+			"ip_version": ipVersion,
 			"name":       dhcpOpt.OptName,
 			"value":      dhcpOpt.OptValue,
 		}
@@ -151,6 +441,30 @@ func flattenNetworkingPortAllowedAddressPairsV2(mac string, allowedAddressPairs
 	return pairs
 }
 
+// networkingPortV2AllowedAddressPairsContainIP checks whether one of the allowed
+// address pairs permits the ip. Pairs may contain either an IP or a CIDR.
+func networkingPortV2AllowedAddressPairsContainIP(allowedAddressPairs []ports.AddressPair, ip string) bool {
+	parsedIP := net.ParseIP(ip)
+	if parsedIP == nil {
+		return false
+	}
+
+	for _, pair := range allowedAddressPairs {
+		if _, cidr, err := net.ParseCIDR(pair.IPAddress); err == nil {
+			if cidr.Contains(parsedIP) {
+				return true
+			}
+			continue
+		}
+
+		if pairIP := net.ParseIP(pair.IPAddress); pairIP != nil && pairIP.Equal(parsedIP) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func expandNetworkingPortFixedIPV2(d *schema.ResourceData) interface{} {
 	// If no_fixed_ip was specified, then just return an empty array.
 	// Since no_fixed_ip is mutually exclusive to fixed_ip,
@@ -187,6 +501,17 @@ func resourceNetworkingPortV2AllowedAddressPairsHash(v interface{}) int {
 	return hashcode.String(buf.String())
 }
 
+// resourceNetworkingPortV2ExtraDHCPOptionHash hashes an extra DHCP option by
+// its name and IP version, which are the option key, and its value. The order
+// of the options returned by Neutron doesn't matter.
+func resourceNetworkingPortV2ExtraDHCPOptionHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%s-%d-%s", m["name"].(string), m["ip_version"].(int), m["value"].(string)))
+
+	return hashcode.String(buf.String())
+}
+
 func expandNetworkingPortFixedIPToStringSlice(fixedIPs []ports.IP) []string {
 	s := make([]string, len(fixedIPs))
 	for i, fixedIP := range fixedIPs {
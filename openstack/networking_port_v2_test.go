@@ -1,12 +1,18 @@
 package openstack
 
 import (
+	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/gophercloud/gophercloud"
+	tokens3 "github.com/gophercloud/gophercloud/openstack/identity/v3/tokens"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/extradhcpopts"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	thclient "github.com/gophercloud/gophercloud/testhelper/client"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -125,10 +131,12 @@ func TestExpandNetworkingPortDHCPOptsV2Delete(t *testing.T) {
 
 	expectedDHCPOptions := []extradhcpopts.UpdateExtraDHCPOpt{
 		{
-			OptName: "B",
+			OptName:   "B",
+			IPVersion: gophercloud.IPVersion(6),
 		},
 		{
-			OptName: "A",
+			OptName:   "A",
+			IPVersion: gophercloud.IPVersion(4),
 		},
 	}
 
@@ -137,6 +145,73 @@ func TestExpandNetworkingPortDHCPOptsV2Delete(t *testing.T) {
 	assert.ElementsMatch(t, expectedDHCPOptions, actualDHCPOptions)
 }
 
+func TestExpandNetworkingPortDHCPOptsV2UpdateIPVersion(t *testing.T) {
+	r := resourceNetworkingPortV2()
+
+	dOld := r.TestResourceData()
+	dOld.SetId("1")
+	dOld.Set("extra_dhcp_option", []map[string]interface{}{
+		{
+			"ip_version": 4,
+			"name":       "dns-server",
+			"value":      "192.168.199.2",
+		},
+		{
+			"ip_version": 6,
+			"name":       "dns-server",
+			"value":      "fd00::2",
+		},
+	})
+
+	dNew := r.TestResourceData()
+	dNew.SetId("1")
+	dNew.Set("extra_dhcp_option", []map[string]interface{}{
+		{
+			"ip_version": 4,
+			"name":       "dns-server",
+			"value":      "192.168.199.2",
+		},
+	})
+
+	optValue := "192.168.199.2"
+	expectedDHCPOptions := []extradhcpopts.UpdateExtraDHCPOpt{
+		{
+			OptName:   "dns-server",
+			OptValue:  &optValue,
+			IPVersion: gophercloud.IPVersion(4),
+		},
+		{
+			OptName:   "dns-server",
+			IPVersion: gophercloud.IPVersion(6),
+		},
+	}
+
+	actualDHCPOptions := expandNetworkingPortDHCPOptsV2Update(
+		dOld.Get("extra_dhcp_option").(*schema.Set), dNew.Get("extra_dhcp_option").(*schema.Set))
+
+	assert.ElementsMatch(t, expectedDHCPOptions, actualDHCPOptions)
+}
+
+func TestResourceNetworkingPortV2ExtraDHCPOptionHash(t *testing.T) {
+	optionV4 := map[string]interface{}{
+		"ip_version": 4,
+		"name":       "dns-server",
+		"value":      "192.168.199.2",
+	}
+	optionV6 := map[string]interface{}{
+		"ip_version": 6,
+		"name":       "dns-server",
+		"value":      "192.168.199.2",
+	}
+
+	assert.Equal(t, resourceNetworkingPortV2ExtraDHCPOptionHash(optionV4), resourceNetworkingPortV2ExtraDHCPOptionHash(optionV4))
+	assert.NotEqual(t, resourceNetworkingPortV2ExtraDHCPOptionHash(optionV4), resourceNetworkingPortV2ExtraDHCPOptionHash(optionV6))
+
+	set1 := schema.NewSet(resourceNetworkingPortV2ExtraDHCPOptionHash, []interface{}{optionV4, optionV6})
+	set2 := schema.NewSet(resourceNetworkingPortV2ExtraDHCPOptionHash, []interface{}{optionV6, optionV4})
+	assert.True(t, set1.Equal(set2))
+}
+
 func TestFlattenNetworkingPort2DHCPOptionsV2(t *testing.T) {
 	dhcpOptions := extradhcpopts.ExtraDHCPOptsExt{
 		ExtraDHCPOpts: []extradhcpopts.ExtraDHCPOpt{
@@ -272,3 +347,195 @@ func TestExpandNetworkingPortFixedIPV2SomeFixedIPs(t *testing.T) {
 
 	assert.ElementsMatch(t, expectedFixedIP, actualFixedIP)
 }
+
+func TestNetworkingPortV2AllowedAddressPairsContainIP(t *testing.T) {
+	allowedAddressPairs := []ports.AddressPair{
+		{
+			IPAddress: "192.168.199.200",
+		},
+		{
+			IPAddress:  "10.0.0.0/24",
+			MACAddress: "fa:16:3e:00:00:01",
+		},
+	}
+
+	assert.True(t, networkingPortV2AllowedAddressPairsContainIP(allowedAddressPairs, "192.168.199.200"))
+	assert.True(t, networkingPortV2AllowedAddressPairsContainIP(allowedAddressPairs, "10.0.0.15"))
+	assert.False(t, networkingPortV2AllowedAddressPairsContainIP(allowedAddressPairs, "192.168.199.201"))
+	assert.False(t, networkingPortV2AllowedAddressPairsContainIP(allowedAddressPairs, "10.0.1.15"))
+	assert.False(t, networkingPortV2AllowedAddressPairsContainIP(nil, "192.168.199.200"))
+}
+
+func TestValidateNetworkingPortV2VNICType(t *testing.T) {
+	for _, vnicType := range []string{"normal", "direct", "smart-nic", "remote-managed", "Baremetal"} {
+		_, errs := validateNetworkingPortV2VNICType(vnicType, "vnic_type")
+		assert.Empty(t, errs, vnicType)
+	}
+
+	for _, vnicType := range []string{"", "foo", "direct_physical"} {
+		_, errs := validateNetworkingPortV2VNICType(vnicType, "vnic_type")
+		assert.NotEmpty(t, errs, vnicType)
+	}
+}
+
+func TestValidateNetworkingPortV2Hints(t *testing.T) {
+	validHints := []string{
+		`{"openvswitch": {"other_config": {"tx-steering": "hash"}}}`,
+		`{}`,
+	}
+	for _, hints := range validHints {
+		_, errs := validateNetworkingPortV2Hints(hints, "hints")
+		assert.Empty(t, errs, hints)
+	}
+
+	invalidHints := []string{
+		"",
+		"foo",
+		`["openvswitch"]`,
+		`{"openvswitch": "hash"}`,
+	}
+	for _, hints := range invalidHints {
+		_, errs := validateNetworkingPortV2Hints(hints, "hints")
+		assert.NotEmpty(t, errs, hints)
+	}
+}
+
+func TestExpandNetworkingPortHintsV2(t *testing.T) {
+	hints, err := expandNetworkingPortHintsV2(`{"openvswitch": {"other_config": {"tx-steering": "thread"}}}`)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"openvswitch": map[string]interface{}{
+			"other_config": map[string]interface{}{
+				"tx-steering": "thread",
+			},
+		},
+	}, hints)
+
+	hints, err = expandNetworkingPortHintsV2("")
+	assert.NoError(t, err)
+	assert.Nil(t, hints)
+}
+
+func TestNetworkingPortV2AdminOnlyFields(t *testing.T) {
+	assert.Empty(t, networkingPortV2AdminOnlyFields("", "", ""))
+	assert.Empty(t, networkingPortV2AdminOnlyFields("", "", "compute:nova"))
+	assert.Equal(t, []string{"binding.host_id", "binding.profile", "device_owner"},
+		networkingPortV2AdminOnlyFields("host1", `{"foo": "bar"}`, "network:dhcp"))
+	assert.Equal(t, []string{"binding.profile"},
+		networkingPortV2AdminOnlyFields("", `{"foo": "bar"}`, "reserved"))
+}
+
+func TestNetworkingPortV2CheckAdminOnlyFields(t *testing.T) {
+	memberRoles := []tokens3.Role{{ID: "1", Name: "member"}, {ID: "2", Name: "reader"}}
+	adminRoles := []tokens3.Role{{ID: "1", Name: "member"}, {ID: "3", Name: "admin"}}
+
+	assert.NoError(t, networkingPortV2CheckAdminOnlyFields(nil, memberRoles))
+	assert.NoError(t, networkingPortV2CheckAdminOnlyFields([]string{"binding.host_id"}, adminRoles))
+
+	err := networkingPortV2CheckAdminOnlyFields([]string{"binding.host_id", "device_owner"}, memberRoles)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "binding.host_id, device_owner")
+	}
+}
+
+func TestResourceNetworkingPortV2Validate(t *testing.T) {
+	_, errs := resourceNetworkingPortV2().Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		"network_id":  "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+		"no_fixed_ip": true,
+		"fixed_ip": []interface{}{
+			map[string]interface{}{
+				"subnet_id": "a0304c3a-4f08-4c43-88af-d796509c97d2",
+			},
+		},
+	}))
+	assert.Contains(t, fmt.Sprint(errs), `"fixed_ip": conflicts with no_fixed_ip`)
+
+	_, errs = resourceNetworkingPortV2().Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		"network_id": "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+		"binding": []interface{}{
+			map[string]interface{}{
+				"profile": "{not json",
+			},
+		},
+	}))
+	assert.Contains(t, fmt.Sprint(errs), `"binding.0.profile" must be a JSON object`)
+}
+
+func TestValidateNetworkingPortV2NUMAAffinityPolicyVNICType(t *testing.T) {
+	assert.NoError(t, validateNetworkingPortV2NUMAAffinityPolicyVNICType("", "normal"))
+	assert.NoError(t, validateNetworkingPortV2NUMAAffinityPolicyVNICType("required", "direct"))
+	assert.NoError(t, validateNetworkingPortV2NUMAAffinityPolicyVNICType("socket", "Direct-Physical"))
+
+	for _, vnicType := range []string{"", "normal", "baremetal"} {
+		err := validateNetworkingPortV2NUMAAffinityPolicyVNICType("preferred", vnicType)
+		assert.Error(t, err, vnicType)
+	}
+}
+
+func TestNetworkingPortV2CheckNUMAAffinityPolicy(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/extensions/port-numa-affinity-policy", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `{"extension": {"alias": "port-numa-affinity-policy", "name": "Port NUMA affinity policy"}}`)
+	})
+
+	th.Mux.HandleFunc("/extensions/port-numa-affinity-policy-socket", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	assert.NoError(t, networkingPortV2CheckNUMAAffinityPolicy(thclient.ServiceClient(), "required"))
+
+	err := networkingPortV2CheckNUMAAffinityPolicy(thclient.ServiceClient(), "socket")
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "port-numa-affinity-policy-socket extension")
+	}
+}
+
+func TestPortNUMAAffinityPolicyOptsExt(t *testing.T) {
+	createOpts := PortNUMAAffinityPolicyCreateOptsExt{
+		CreateOptsBuilder:  ports.CreateOpts{NetworkID: "a87cc70a-3e15-4acf-8205-9b711a3531b7"},
+		NUMAAffinityPolicy: "preferred",
+	}
+
+	b, err := createOpts.ToPortCreateMap()
+	assert.NoError(t, err)
+	assert.Equal(t, "preferred", b["port"].(map[string]interface{})["numa_affinity_policy"])
+
+	policy := ""
+	updateOpts := PortNUMAAffinityPolicyUpdateOptsExt{
+		UpdateOptsBuilder:  ports.UpdateOpts{},
+		NUMAAffinityPolicy: &policy,
+	}
+
+	b, err = updateOpts.ToPortUpdateMap()
+	assert.NoError(t, err)
+	v, ok := b["port"].(map[string]interface{})["numa_affinity_policy"]
+	assert.True(t, ok)
+	assert.Nil(t, v)
+}
+
+func TestResourceNetworkingPortV2DiffNetworkID(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "b6d0dbf8-31c3-4a36-b4ea-2b5c4ee3c9e1",
+		Attributes: map[string]string{
+			"id":         "b6d0dbf8-31c3-4a36-b4ea-2b5c4ee3c9e1",
+			"network_id": "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+		},
+	}
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"network_id": "ad4ed6e4-4f8f-4ff8-9bb1-a4d8e4d0e7a5",
+	})
+
+	diff, err := resourceNetworkingPortV2().Diff(state, config, &Config{})
+	assert.NoError(t, err)
+	if assert.NotNil(t, diff) {
+		assert.True(t, diff.RequiresNew())
+		assert.True(t, diff.Attributes["network_id"].RequiresNew)
+	}
+}
@@ -1,12 +1,26 @@
 package openstack
 
 import (
+	"regexp"
+
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/portforwarding"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 )
 
+type portForwardingExtended struct {
+	portforwarding.PortForwarding
+	PortForwardingExt
+}
+
+// validateNetworkingPortForwardingV2PortRange validates a port range in the
+// "first:last" form.
+var validateNetworkingPortForwardingV2PortRange schema.SchemaValidateFunc = validation.StringMatch(
+	regexp.MustCompile(`^[0-9]+:[0-9]+$`), "must be a port range in the \"first:last\" form")
+
 func networkingPortForwardingV2StateRefreshFunc(client *gophercloud.ServiceClient, fipID, pfID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		pf, err := portforwarding.Get(client, fipID, pfID).Extract()
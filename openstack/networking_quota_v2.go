@@ -0,0 +1,35 @@
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/quotas"
+)
+
+func flattenNetworkingQuotaV2Details(details *quotas.QuotaDetailSet) []map[string]interface{} {
+	resources := []struct {
+		name   string
+		detail quotas.QuotaDetail
+	}{
+		{"floatingip", details.FloatingIP},
+		{"network", details.Network},
+		{"port", details.Port},
+		{"rbac_policy", details.RBACPolicy},
+		{"router", details.Router},
+		{"security_group", details.SecurityGroup},
+		{"security_group_rule", details.SecurityGroupRule},
+		{"subnet", details.Subnet},
+		{"subnetpool", details.SubnetPool},
+		{"trunk", details.Trunk},
+	}
+
+	res := make([]map[string]interface{}, len(resources))
+	for i, r := range resources {
+		res[i] = map[string]interface{}{
+			"resource": r.name,
+			"used":     r.detail.Used,
+			"reserved": r.detail.Reserved,
+			"limit":    r.detail.Limit,
+		}
+	}
+
+	return res
+}
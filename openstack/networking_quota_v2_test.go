@@ -0,0 +1,38 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/quotas"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlattenNetworkingQuotaV2Details(t *testing.T) {
+	details := &quotas.QuotaDetailSet{
+		Network: quotas.QuotaDetail{
+			Used:  1,
+			Limit: 10,
+		},
+		Port: quotas.QuotaDetail{
+			Used:     3,
+			Reserved: 1,
+			Limit:    50,
+		},
+	}
+
+	actual := flattenNetworkingQuotaV2Details(details)
+
+	assert.Len(t, actual, 10)
+	assert.Equal(t, map[string]interface{}{
+		"resource": "network",
+		"used":     1,
+		"reserved": 0,
+		"limit":    10,
+	}, actual[1])
+	assert.Equal(t, map[string]interface{}{
+		"resource": "port",
+		"used":     3,
+		"reserved": 1,
+		"limit":    50,
+	}, actual[2])
+}
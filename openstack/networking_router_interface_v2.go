@@ -1,7 +1,9 @@
 package openstack
 
 import (
+	"fmt"
 	"log"
+	"strings"
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/routers"
@@ -64,3 +66,82 @@ func resourceNetworkingRouterInterfaceV2DeleteRefreshFunc(networkingClient *goph
 		return r, "ACTIVE", nil
 	}
 }
+
+// networkingRouterInterfaceV2SubnetPorts returns the ports which use the subnets
+// of the router interface port, except the ones owned by Neutron itself.
+func networkingRouterInterfaceV2SubnetPorts(networkingClient *gophercloud.ServiceClient, portID string) ([]ports.Port, error) {
+	port, err := ports.Get(networkingClient, portID).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []ports.Port
+	seen := map[string]bool{portID: true}
+	for _, fixedIP := range port.FixedIPs {
+		listOpts := ports.ListOpts{
+			FixedIPs: []ports.FixedIPOpts{
+				{SubnetID: fixedIP.SubnetID},
+			},
+		}
+
+		allPages, err := ports.List(networkingClient, listOpts).AllPages()
+		if err != nil {
+			return nil, fmt.Errorf("Error listing ports of subnet %s: %s", fixedIP.SubnetID, err)
+		}
+
+		allPorts, err := ports.ExtractPorts(allPages)
+		if err != nil {
+			return nil, fmt.Errorf("Error extracting ports of subnet %s: %s", fixedIP.SubnetID, err)
+		}
+
+		for _, p := range allPorts {
+			if seen[p.ID] || strings.HasPrefix(p.DeviceOwner, "network:") {
+				continue
+			}
+			seen[p.ID] = true
+			result = append(result, p)
+		}
+	}
+
+	return result, nil
+}
+
+// networkingRouterInterfaceV2LingeringDeviceOwners are the device owners of
+// the ports, which are left behind by deleted load balancers. Such ports may
+// keep a floating IP association through the router, which blocks the removal
+// of the router interface.
+var networkingRouterInterfaceV2LingeringDeviceOwners = map[string]bool{
+	"neutron:LOADBALANCERV2": true,
+	"Octavia":                true,
+}
+
+// networkingRouterInterfaceV2DeleteLingeringPorts deletes the ports which use
+// the subnets of the router interface port, aren't attached to any device and
+// are owned by one of networkingRouterInterfaceV2LingeringDeviceOwners. Other
+// ports are left alone and reported if the interface can't be removed.
+func networkingRouterInterfaceV2DeleteLingeringPorts(networkingClient *gophercloud.ServiceClient, portID string) error {
+	subnetPorts, err := networkingRouterInterfaceV2SubnetPorts(networkingClient, portID)
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); ok {
+			return nil
+		}
+		return fmt.Errorf("Error retrieving ports of openstack_networking_router_interface_v2 %s: %s", portID, err)
+	}
+
+	for _, port := range subnetPorts {
+		if port.DeviceID != "" || !networkingRouterInterfaceV2LingeringDeviceOwners[port.DeviceOwner] {
+			continue
+		}
+
+		log.Printf("[DEBUG] Deleting lingering port %s of openstack_networking_router_interface_v2 %s", port.ID, portID)
+		err := ports.Delete(networkingClient, port.ID).ExtractErr()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				continue
+			}
+			return fmt.Errorf("Error deleting lingering port %s of openstack_networking_router_interface_v2 %s: %s", port.ID, portID, err)
+		}
+	}
+
+	return nil
+}
@@ -1,11 +1,24 @@
 package openstack
 
 import (
+	"fmt"
+	"log"
+	"time"
+
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/routers"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 )
 
+// routerExtended represents a router with the attributes of the dvr and
+// l3-ha extensions. They are pointers, because they are missing when the
+// extension isn't enabled or the attribute is restricted to admins.
+type routerExtended struct {
+	routers.Router
+	Distributed *bool `json:"distributed"`
+	HA          *bool `json:"ha"`
+}
+
 func resourceNetworkingRouterV2StateRefreshFunc(client *gophercloud.ServiceClient, routerID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		n, err := routers.Get(client, routerID).Extract()
@@ -21,6 +34,96 @@ func resourceNetworkingRouterV2StateRefreshFunc(client *gophercloud.ServiceClien
 	}
 }
 
+// networkingRouterV2GatewayStateRefreshFunc returns a resource.StateRefreshFunc
+// to wait for a router's external gateway and its fixed IPs to be populated.
+func networkingRouterV2GatewayStateRefreshFunc(client *gophercloud.ServiceClient, routerID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		r, err := routers.Get(client, routerID).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+
+		if r.GatewayInfo.NetworkID == "" || len(r.GatewayInfo.ExternalFixedIPs) == 0 {
+			log.Printf("[DEBUG] openstack_networking_router_v2 %s external gateway is not ready yet", routerID)
+			return r, "PENDING", nil
+		}
+
+		return r, "ACTIVE", nil
+	}
+}
+
+// networkingRouterV2WaitForGateway waits until the external gateway of a router is ready.
+func networkingRouterV2WaitForGateway(client *gophercloud.ServiceClient, routerID string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Waiting for openstack_networking_router_v2 %s external gateway to become ready", routerID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"PENDING"},
+		Target:     []string{"ACTIVE"},
+		Refresh:    networkingRouterV2GatewayStateRefreshFunc(client, routerID),
+		Timeout:    timeout,
+		Delay:      0,
+		MinTimeout: 2 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for openstack_networking_router_v2 %s external gateway to become ready: %s", routerID, err)
+	}
+
+	return nil
+}
+
+// networkingRouterV2UpdateMode changes the distributed and ha attributes of a
+// router. Neutron only allows this while the router is administratively down,
+// so the router is taken down first and brought up again afterwards, if
+// adminStateUp is set.
+func networkingRouterV2UpdateMode(client *gophercloud.ServiceClient, routerID string, distributed, ha *bool, adminStateUp bool) error {
+	r, err := routers.Get(client, routerID).Extract()
+	if err != nil {
+		return fmt.Errorf("Error retrieving openstack_networking_router_v2 %s: %s", routerID, err)
+	}
+
+	if r.AdminStateUp {
+		log.Printf("[DEBUG] Setting admin_state_up of openstack_networking_router_v2 %s to false to change its mode", routerID)
+
+		asu := false
+		if _, err := routers.Update(client, routerID, routers.UpdateOpts{AdminStateUp: &asu}).Extract(); err != nil {
+			return fmt.Errorf("Error setting admin_state_up of openstack_networking_router_v2 %s to false: %s", routerID, err)
+		}
+	}
+
+	modeOpts := RouterHAUpdateOptsExt{
+		UpdateOptsBuilder: routers.UpdateOpts{
+			Distributed: distributed,
+		},
+		HA: ha,
+	}
+
+	log.Printf("[DEBUG] openstack_networking_router_v2 %s mode update options: %#v", routerID, modeOpts)
+	if _, err := routers.Update(client, routerID, modeOpts).Extract(); err != nil {
+		err = fmt.Errorf("Error updating the mode of openstack_networking_router_v2 %s: %s", routerID, err)
+
+		// Don't leave a router, which was up, down after a failed update.
+		if r.AdminStateUp {
+			asu := true
+			if _, upErr := routers.Update(client, routerID, routers.UpdateOpts{AdminStateUp: &asu}).Extract(); upErr != nil {
+				return fmt.Errorf("%s. Error restoring admin_state_up of openstack_networking_router_v2 %s to true: %s", err, routerID, upErr)
+			}
+		}
+
+		return err
+	}
+
+	if adminStateUp {
+		asu := true
+		if _, err := routers.Update(client, routerID, routers.UpdateOpts{AdminStateUp: &asu}).Extract(); err != nil {
+			return fmt.Errorf("Error setting admin_state_up of openstack_networking_router_v2 %s to true: %s", routerID, err)
+		}
+	}
+
+	return nil
+}
+
 func expandNetworkingRouterExternalFixedIPsV2(externalFixedIPs []interface{}) []routers.ExternalFixedIP {
 	fixedIPs := make([]routers.ExternalFixedIP, len(externalFixedIPs))
 
@@ -60,3 +163,142 @@ func flattenNetworkingRouterExternalFixedIPsV2(externalFixedIPs []routers.Extern
 
 	return fixedIPs
 }
+
+func expandNetworkingRouterRoutesV2(rawRoutes []interface{}) []routers.Route {
+	routes := make([]routers.Route, len(rawRoutes))
+
+	for i, raw := range rawRoutes {
+		rawMap := raw.(map[string]interface{})
+
+		routes[i] = routers.Route{
+			DestinationCIDR: rawMap["destination_cidr"].(string),
+			NextHop:         rawMap["next_hop"].(string),
+		}
+	}
+
+	return routes
+}
+
+func flattenNetworkingRouterRoutesV2(routes []routers.Route) []map[string]string {
+	result := make([]map[string]string, len(routes))
+
+	for i, route := range routes {
+		result[i] = map[string]string{
+			"destination_cidr": route.DestinationCIDR,
+			"next_hop":         route.NextHop,
+		}
+	}
+
+	return result
+}
+
+// networkingRouterV2AddRoutes adds routes to a router without touching
+// the routes which are already there. The extraroute-atomic extension
+// is used when available, otherwise the full list of routes is updated,
+// so callers must hold the router lock.
+func networkingRouterV2AddRoutes(config *Config, client *gophercloud.ServiceClient, routerID string, routes []routers.Route) error {
+	atomic, err := config.networkingExtensions.Available(client, "extraroute-atomic")
+	if err != nil {
+		return err
+	}
+
+	if !atomic {
+		return networkingRouterV2ReplaceRoutes(client, routerID, func(existing []routers.Route) []routers.Route {
+			result := existing
+			for _, route := range routes {
+				if !networkingRouterV2RoutesContain(existing, route) {
+					result = append(result, route)
+				}
+			}
+			return result
+		})
+	}
+
+	log.Printf("[DEBUG] Adding routes to openstack_networking_router_v2 %s: %#v", routerID, routes)
+	err = networkingRouterV2ExtraRoutesAction(client, routerID, "add_extraroutes", routes)
+	if err != nil {
+		return fmt.Errorf("Error adding routes to openstack_networking_router_v2 %s: %s", routerID, err)
+	}
+
+	return nil
+}
+
+// networkingRouterV2RemoveRoutes removes routes from a router without touching
+// the other routes. The extraroute-atomic extension is used when available,
+// otherwise the full list of routes is updated, so callers must hold the
+// router lock.
+func networkingRouterV2RemoveRoutes(config *Config, client *gophercloud.ServiceClient, routerID string, routes []routers.Route) error {
+	atomic, err := config.networkingExtensions.Available(client, "extraroute-atomic")
+	if err != nil {
+		return err
+	}
+
+	if !atomic {
+		return networkingRouterV2ReplaceRoutes(client, routerID, func(existing []routers.Route) []routers.Route {
+			result := []routers.Route{}
+			for _, route := range existing {
+				if !networkingRouterV2RoutesContain(routes, route) {
+					result = append(result, route)
+				}
+			}
+			return result
+		})
+	}
+
+	log.Printf("[DEBUG] Removing routes from openstack_networking_router_v2 %s: %#v", routerID, routes)
+	err = networkingRouterV2ExtraRoutesAction(client, routerID, "remove_extraroutes", routes)
+	if err != nil {
+		return fmt.Errorf("Error removing routes from openstack_networking_router_v2 %s: %s", routerID, err)
+	}
+
+	return nil
+}
+
+// networkingRouterV2ExtraRoutesAction calls the add_extraroutes or
+// remove_extraroutes action of the extraroute-atomic extension, which
+// gophercloud doesn't support yet.
+func networkingRouterV2ExtraRoutesAction(client *gophercloud.ServiceClient, routerID, action string, routes []routers.Route) error {
+	b := map[string]interface{}{
+		"router": map[string]interface{}{
+			"routes": routes,
+		},
+	}
+
+	_, err := client.Put(client.ServiceURL("routers", routerID, action), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	return err
+}
+
+// networkingRouterV2ReplaceRoutes updates the full list of router routes
+// using the result of the modify function.
+func networkingRouterV2ReplaceRoutes(client *gophercloud.ServiceClient, routerID string, modify func([]routers.Route) []routers.Route) error {
+	r, err := routers.Get(client, routerID).Extract()
+	if err != nil {
+		return fmt.Errorf("Error getting openstack_networking_router_v2 %s: %s", routerID, err)
+	}
+
+	routes := modify(r.Routes)
+	updateOpts := routers.UpdateOpts{
+		Routes: &routes,
+	}
+
+	log.Printf("[DEBUG] openstack_networking_router_v2 %s update options: %#v", routerID, updateOpts)
+	_, err = routers.Update(client, routerID, updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating openstack_networking_router_v2 %s: %s", routerID, err)
+	}
+
+	return nil
+}
+
+func networkingRouterV2RoutesContain(routes []routers.Route, route routers.Route) bool {
+	for _, r := range routes {
+		if r.DestinationCIDR == route.DestinationCIDR && r.NextHop == route.NextHop {
+			return true
+		}
+	}
+
+	return false
+}
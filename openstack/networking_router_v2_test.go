@@ -1,9 +1,15 @@
 package openstack
 
 import (
+	"encoding/json"
+	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/routers"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	thclient "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -65,3 +71,167 @@ func TestFlattenNetworkingRouterExternalFixedIPsV2(t *testing.T) {
 
 	assert.ElementsMatch(t, expectedExternalFixedIPs, actualExternalFixedIPs)
 }
+
+func TestExpandNetworkingRouterRoutesV2(t *testing.T) {
+	r := resourceNetworkingRouterV2()
+	d := r.TestResourceData()
+	d.SetId("1")
+	routes := []map[string]string{
+		{
+			"destination_cidr": "10.0.1.0/24",
+			"next_hop":         "192.168.199.10",
+		},
+		{
+			"destination_cidr": "10.0.2.0/24",
+			"next_hop":         "192.168.199.20",
+		},
+	}
+	d.Set("routes", routes)
+
+	expectedRoutes := []routers.Route{
+		{
+			DestinationCIDR: "10.0.1.0/24",
+			NextHop:         "192.168.199.10",
+		},
+		{
+			DestinationCIDR: "10.0.2.0/24",
+			NextHop:         "192.168.199.20",
+		},
+	}
+
+	actualRoutes := expandNetworkingRouterRoutesV2(d.Get("routes").(*schema.Set).List())
+
+	assert.ElementsMatch(t, expectedRoutes, actualRoutes)
+}
+
+func TestFlattenNetworkingRouterRoutesV2(t *testing.T) {
+	routes := []routers.Route{
+		{
+			DestinationCIDR: "10.0.1.0/24",
+			NextHop:         "192.168.199.10",
+		},
+		{
+			DestinationCIDR: "10.0.2.0/24",
+			NextHop:         "192.168.199.20",
+		},
+	}
+
+	expectedRoutes := []map[string]string{
+		{
+			"destination_cidr": "10.0.1.0/24",
+			"next_hop":         "192.168.199.10",
+		},
+		{
+			"destination_cidr": "10.0.2.0/24",
+			"next_hop":         "192.168.199.20",
+		},
+	}
+
+	actualRoutes := flattenNetworkingRouterRoutesV2(routes)
+
+	assert.ElementsMatch(t, expectedRoutes, actualRoutes)
+}
+
+func TestDataSourceNetworkingRouterV2ListOpts(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, dataSourceNetworkingRouterV2().Schema, map[string]interface{}{
+		"name":   "router_1",
+		"status": "ACTIVE",
+	})
+
+	listOpts := dataSourceNetworkingRouterV2ListOpts(d)
+
+	assert.Equal(t, "router_1", listOpts.Name)
+	assert.Equal(t, "ACTIVE", listOpts.Status)
+	assert.Equal(t, "", listOpts.ID)
+	assert.Nil(t, listOpts.AdminStateUp)
+}
+
+func TestNetworkingRouterV2ExtraRoutesAction(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/routers/router_id/add_extraroutes", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestJSONRequest(t, r, `{"router": {"routes": [{"destination": "10.0.1.0/24", "nexthop": "192.168.199.254"}]}}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `{"router": {"id": "router_id", "routes": [{"destination": "10.0.1.0/24", "nexthop": "192.168.199.254"}]}}`)
+	})
+
+	routes := []routers.Route{
+		{
+			DestinationCIDR: "10.0.1.0/24",
+			NextHop:         "192.168.199.254",
+		},
+	}
+
+	err := networkingRouterV2ExtraRoutesAction(thclient.ServiceClient(), "router_id", "add_extraroutes", routes)
+	assert.NoError(t, err)
+}
+
+func TestNetworkingRouterV2UpdateMode_restoreAdminStateUp(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	var adminStateUps []bool
+	th.Mux.HandleFunc("/routers/router_id", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+
+		if r.Method == "GET" {
+			fmt.Fprint(w, `{"router": {"id": "router_id", "admin_state_up": true}}`)
+			return
+		}
+
+		th.TestMethod(t, r, "PUT")
+
+		var b struct {
+			Router struct {
+				AdminStateUp *bool `json:"admin_state_up"`
+				Distributed  *bool `json:"distributed"`
+			} `json:"router"`
+		}
+		th.AssertNoErr(t, json.NewDecoder(r.Body).Decode(&b))
+
+		if b.Router.Distributed != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"NeutronError": {"message": "Cannot upgrade active router to distributed"}}`)
+			return
+		}
+
+		adminStateUps = append(adminStateUps, *b.Router.AdminStateUp)
+		fmt.Fprint(w, `{"router": {"id": "router_id"}}`)
+	})
+
+	distributed := true
+	err := networkingRouterV2UpdateMode(thclient.ServiceClient(), "router_id", &distributed, nil, true)
+	assert.Error(t, err)
+	assert.Equal(t, []bool{false, true}, adminStateUps)
+}
+
+func TestNetworkingV2ExtensionCache(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	var calls int
+	th.Mux.HandleFunc("/extensions/extraroute-atomic", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		calls++
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `{"extension": {"alias": "extraroute-atomic", "name": "Atomically add/remove extra routes"}}`)
+	})
+
+	var cache networkingV2ExtensionCache
+	client := thclient.ServiceClient()
+
+	for i := 0; i < 2; i++ {
+		available, err := cache.Available(client, "extraroute-atomic")
+		assert.NoError(t, err)
+		assert.True(t, available)
+	}
+	assert.Equal(t, 1, calls)
+
+	available, err := cache.Available(client, "l3-ha")
+	assert.NoError(t, err)
+	assert.False(t, available)
+}
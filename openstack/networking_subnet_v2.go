@@ -3,8 +3,10 @@ package openstack
 import (
 	"fmt"
 	"log"
+	"net"
 
 	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/subnets"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -62,6 +64,36 @@ func networkingSubnetV2StateRefreshFuncDelete(networkingClient *gophercloud.Serv
 	}
 }
 
+// networkingSubnetV2DHCPPortsStateRefreshFunc returns a resource.StateRefreshFunc to wait
+// for the DHCP agent to release its ports on a subnet.
+func networkingSubnetV2DHCPPortsStateRefreshFunc(client *gophercloud.ServiceClient, subnetID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		listOpts := ports.ListOpts{
+			DeviceOwner: "network:dhcp",
+			FixedIPs: []ports.FixedIPOpts{
+				{SubnetID: subnetID},
+			},
+		}
+
+		allPages, err := ports.List(client, listOpts).AllPages()
+		if err != nil {
+			return nil, "", err
+		}
+
+		allPorts, err := ports.ExtractPorts(allPages)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if len(allPorts) > 0 {
+			log.Printf("[DEBUG] openstack_networking_subnet_v2 %s still has %d DHCP port(s)", subnetID, len(allPorts))
+			return allPorts, "PENDING", nil
+		}
+
+		return allPorts, "RELEASED", nil
+	}
+}
+
 // networkingSubnetV2GetRawAllocationPoolsValueToExpand selects the resource argument to populate
 // the allocations pool value.
 func networkingSubnetV2GetRawAllocationPoolsValueToExpand(d *schema.ResourceData) []interface{} {
@@ -144,29 +176,60 @@ func networkingSubnetV2AllocationPoolsMatch(oldPools, newPools []interface{}) bo
 		return false
 	}
 
-	for _, newPool := range newPools {
-		var found bool
+	added, removed := networkingSubnetV2AllocationPoolsDelta(oldPools, newPools)
 
-		newPoolPool := newPool.(map[string]interface{})
-		newStart := newPoolPool["start"].(string)
-		newEnd := newPoolPool["end"].(string)
+	return len(added) == 0 && len(removed) == 0
+}
 
-		for _, oldPool := range oldPools {
-			oldPoolPool := oldPool.(map[string]interface{})
-			oldStart := oldPoolPool["start"].(string)
-			oldEnd := oldPoolPool["end"].(string)
+// networkingSubnetV2AllocationPoolsDelta returns the allocation pools which are
+// present only in newPools (added) and only in oldPools (removed), regardless
+// of their order.
+func networkingSubnetV2AllocationPoolsDelta(oldPools, newPools []interface{}) (added, removed []subnets.AllocationPool) {
+	oldSet := make(map[subnets.AllocationPool]int)
+	for _, pool := range expandNetworkingSubnetV2AllocationPools(oldPools) {
+		oldSet[pool]++
+	}
 
-			if oldStart == newStart && oldEnd == newEnd {
-				found = true
-			}
+	for _, pool := range expandNetworkingSubnetV2AllocationPools(newPools) {
+		if oldSet[pool] > 0 {
+			oldSet[pool]--
+			continue
+		}
+		added = append(added, pool)
+	}
+
+	for _, pool := range expandNetworkingSubnetV2AllocationPools(oldPools) {
+		if oldSet[pool] > 0 {
+			oldSet[pool]--
+			removed = append(removed, pool)
 		}
+	}
+
+	return added, removed
+}
 
-		if !found {
-			return false
+// networkingSubnetV2DefaultGatewayIP returns the first host address of the cidr,
+// which is the gateway Neutron assigns by default.
+func networkingSubnetV2DefaultGatewayIP(cidr string) (string, error) {
+	_, netAddr, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return "", fmt.Errorf("unable to parse cidr %s: %s", cidr, err)
+	}
+
+	ip := make(net.IP, len(netAddr.IP))
+	copy(ip, netAddr.IP)
+	for i := len(ip) - 1; i >= 0; i-- {
+		ip[i]++
+		if ip[i] != 0 {
+			break
 		}
 	}
 
-	return true
+	if !netAddr.Contains(ip) {
+		return "", fmt.Errorf("cidr %s is too small to hold a gateway", cidr)
+	}
+
+	return ip.String(), nil
 }
 
 func networkingSubnetV2DNSNameserverAreUnique(raw []interface{}) error {
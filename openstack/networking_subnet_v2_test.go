@@ -206,6 +206,49 @@ func TestNetworkingSubnetV2AllocationPoolsMatch(t *testing.T) {
 	assert.Equal(t, same, false)
 }
 
+func TestNetworkingSubnetV2AllocationPoolsDelta(t *testing.T) {
+	oldPools := []interface{}{
+		map[string]interface{}{
+			"start": "10.3.0.2",
+			"end":   "10.3.0.255",
+		},
+		map[string]interface{}{
+			"start": "10.3.255.0",
+			"end":   "10.3.255.254",
+		},
+	}
+
+	newPools := []interface{}{
+		map[string]interface{}{
+			"start": "10.3.255.0",
+			"end":   "10.3.255.254",
+		},
+		map[string]interface{}{
+			"start": "10.3.0.2",
+			"end":   "10.3.0.255",
+		},
+	}
+
+	added, removed := networkingSubnetV2AllocationPoolsDelta(oldPools, newPools)
+	assert.Empty(t, added)
+	assert.Empty(t, removed)
+
+	newPools = []interface{}{
+		map[string]interface{}{
+			"start": "10.3.255.10",
+			"end":   "10.3.255.154",
+		},
+		map[string]interface{}{
+			"start": "10.3.0.2",
+			"end":   "10.3.0.255",
+		},
+	}
+
+	added, removed = networkingSubnetV2AllocationPoolsDelta(oldPools, newPools)
+	assert.Equal(t, []subnets.AllocationPool{{Start: "10.3.255.10", End: "10.3.255.154"}}, added)
+	assert.Equal(t, []subnets.AllocationPool{{Start: "10.3.255.0", End: "10.3.255.254"}}, removed)
+}
+
 func TestNetworkingSubnetV2DNSNameserverAreUnique(t *testing.T) {
 	tableTest := []struct {
 		input []interface{}
@@ -229,3 +272,42 @@ func TestNetworkingSubnetV2DNSNameserverAreUnique(t *testing.T) {
 		assert.Equal(t, test.err, networkingSubnetV2DNSNameserverAreUnique(test.input))
 	}
 }
+
+func TestNetworkingSubnetV2DefaultGatewayIP(t *testing.T) {
+	tableTest := []struct {
+		cidr     string
+		expected string
+		err      bool
+	}{
+		{
+			cidr:     "192.168.199.0/24",
+			expected: "192.168.199.1",
+		},
+		{
+			cidr:     "10.0.0.128/25",
+			expected: "10.0.0.129",
+		},
+		{
+			cidr:     "fd00:1234::/64",
+			expected: "fd00:1234::1",
+		},
+		{
+			cidr: "192.168.199.1/32",
+			err:  true,
+		},
+		{
+			cidr: "not-a-cidr",
+			err:  true,
+		},
+	}
+
+	for _, test := range tableTest {
+		actual, err := networkingSubnetV2DefaultGatewayIP(test.cidr)
+		if test.err {
+			assert.Error(t, err)
+			continue
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, test.expected, actual)
+	}
+}
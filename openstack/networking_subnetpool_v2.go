@@ -1,6 +1,11 @@
 package openstack
 
 import (
+	"fmt"
+	"math"
+	"math/big"
+	"net"
+
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/subnetpools"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -23,3 +28,36 @@ func networkingSubnetpoolV2StateRefreshFunc(client *gophercloud.ServiceClient, i
 		return subnetpool, "ACTIVE", nil
 	}
 }
+
+// networkingSubnetPoolV2PrefixSpace returns the size of the cidrs in the unit
+// of the subnet pool quotas: addresses for IPv4 and /64 prefixes for IPv6.
+// IPv6 prefixes longer than /64 don't count, and the size is capped at
+// math.MaxInt64.
+func networkingSubnetPoolV2PrefixSpace(cidrs []string) (int64, error) {
+	total := new(big.Int)
+
+	for _, cidr := range cidrs {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return 0, fmt.Errorf("Error parsing %s: %s", cidr, err)
+		}
+
+		ones, bits := ipNet.Mask.Size()
+		hostBits := bits - ones
+		if bits == net.IPv6len*8 {
+			hostBits -= 64
+		}
+
+		if hostBits < 0 {
+			continue
+		}
+
+		total.Add(total, new(big.Int).Lsh(big.NewInt(1), uint(hostBits)))
+	}
+
+	if !total.IsInt64() {
+		return math.MaxInt64, nil
+	}
+
+	return total.Int64(), nil
+}
@@ -0,0 +1,45 @@
+package openstack
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetworkingSubnetPoolV2PrefixSpace(t *testing.T) {
+	testCases := []struct {
+		cidrs    []string
+		expected int64
+	}{
+		{
+			cidrs:    []string{},
+			expected: 0,
+		},
+		{
+			cidrs:    []string{"10.10.0.0/16", "10.11.11.0/24"},
+			expected: 65536 + 256,
+		},
+		{
+			cidrs:    []string{"10.10.0.0/30"},
+			expected: 4,
+		},
+		{
+			cidrs:    []string{"2001:db8::/48", "2001:db8:1::/64", "2001:db8:2::/120"},
+			expected: 65536 + 1,
+		},
+		{
+			cidrs:    []string{"::/0"},
+			expected: math.MaxInt64,
+		},
+	}
+
+	for _, tc := range testCases {
+		actual, err := networkingSubnetPoolV2PrefixSpace(tc.cidrs)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.expected, actual)
+	}
+
+	_, err := networkingSubnetPoolV2PrefixSpace([]string{"10.10.0.0"})
+	assert.Error(t, err)
+}
@@ -2,9 +2,12 @@ package openstack
 
 import (
 	"encoding/json"
+	"fmt"
 	"log"
+	"sync"
 
 	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
@@ -73,3 +76,40 @@ func decodeNeutronError(body []byte) (*neutronError, error) {
 
 	return &e.NeutronError, nil
 }
+
+// networkingV2ExtensionCache remembers which Neutron extensions are available,
+// so that each extension is only looked up once per endpoint. The zero value
+// is ready to use.
+type networkingV2ExtensionCache struct {
+	mu        sync.Mutex
+	available map[string]bool
+}
+
+// Available checks whether the Neutron extension with the given alias is
+// available.
+func (c *networkingV2ExtensionCache) Available(client *gophercloud.ServiceClient, alias string) (bool, error) {
+	key := client.ServiceURL("extensions", alias)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if available, ok := c.available[key]; ok {
+		return available, nil
+	}
+
+	available := true
+	if _, err := extensions.Get(client, alias).Extract(); err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); !ok {
+			return false, fmt.Errorf("Error checking the %s extension: %s", alias, err)
+		}
+
+		available = false
+	}
+
+	if c.available == nil {
+		c.available = make(map[string]bool)
+	}
+	c.available[key] = available
+
+	return available, nil
+}
@@ -0,0 +1,67 @@
+package openstack
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/objects"
+)
+
+// flattenObjectStorageObjectV1Metadata maps the object metadata back to the
+// configured keys. Swift returns the keys in the canonical header format,
+// e.g. "Foo-Bar" for "foo-bar", so they are matched case-insensitively.
+func flattenObjectStorageObjectV1Metadata(configured map[string]interface{}, remote map[string]string) map[string]string {
+	metadata := make(map[string]string, len(remote))
+
+	for remoteKey, v := range remote {
+		key := remoteKey
+		for configuredKey := range configured {
+			if strings.EqualFold(configuredKey, remoteKey) {
+				key = configuredKey
+				break
+			}
+		}
+
+		metadata[key] = v
+	}
+
+	return metadata
+}
+
+// flattenObjectStorageObjectsV1 splits a container listing into the object
+// names, the pseudo-directories returned for a delimiter and the objects.
+func flattenObjectStorageObjectsV1(allObjects []objects.Object) ([]string, []string, []map[string]interface{}) {
+	names := []string{}
+	subdirs := []string{}
+	flatObjects := []map[string]interface{}{}
+
+	for _, object := range allObjects {
+		if object.Subdir != "" {
+			subdirs = append(subdirs, object.Subdir)
+			continue
+		}
+
+		names = append(names, object.Name)
+		flatObjects = append(flatObjects, map[string]interface{}{
+			"name":         object.Name,
+			"size":         object.Bytes,
+			"content_type": object.ContentType,
+			"etag":         object.Hash,
+		})
+	}
+
+	return names, subdirs, flatObjects
+}
+
+// objectStorageObjectV1SymlinkTarget returns the target and the target
+// account of a symlink object, which was retrieved with symlink=get. Both are
+// empty for a regular object.
+func objectStorageObjectV1SymlinkTarget(header http.Header) (string, string) {
+	target := header.Get("X-Symlink-Target")
+	if v, err := url.PathUnescape(target); err == nil {
+		target = v
+	}
+
+	return target, header.Get("X-Symlink-Target-Account")
+}
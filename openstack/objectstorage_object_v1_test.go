@@ -0,0 +1,80 @@
+package openstack
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/objects"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlattenObjectStorageObjectV1Metadata(t *testing.T) {
+	configured := map[string]interface{}{
+		"foo":     "bar",
+		"foo-bar": "baz",
+	}
+
+	remote := map[string]string{
+		"Foo":     "bar",
+		"Foo-Bar": "qux",
+		"Added":   "externally",
+	}
+
+	expected := map[string]string{
+		"foo":     "bar",
+		"foo-bar": "qux",
+		"Added":   "externally",
+	}
+
+	assert.Equal(t, expected, flattenObjectStorageObjectV1Metadata(configured, remote))
+}
+
+func TestFlattenObjectStorageObjectsV1(t *testing.T) {
+	allObjects := []objects.Object{
+		{
+			Name:        "logs/a.txt",
+			Bytes:       3,
+			ContentType: "text/plain",
+			Hash:        "acbd18db4cc2f85cedef654fccc4a4d8",
+		},
+		{
+			Subdir: "logs/archive/",
+		},
+	}
+
+	names, subdirs, flatObjects := flattenObjectStorageObjectsV1(allObjects)
+	assert.Equal(t, []string{"logs/a.txt"}, names)
+	assert.Equal(t, []string{"logs/archive/"}, subdirs)
+	assert.Equal(t, []map[string]interface{}{
+		{
+			"name":         "logs/a.txt",
+			"size":         int64(3),
+			"content_type": "text/plain",
+			"etag":         "acbd18db4cc2f85cedef654fccc4a4d8",
+		},
+	}, flatObjects)
+}
+
+func TestObjectSymlinkGetOptsExt(t *testing.T) {
+	_, query, err := ObjectSymlinkGetOptsExt{objects.GetOpts{}}.ToObjectGetParams()
+	assert.NoError(t, err)
+	assert.Equal(t, "?symlink=get", query)
+
+	_, query, err = ObjectSymlinkGetOptsExt{objects.GetOpts{Expires: "1516294800"}}.ToObjectGetParams()
+	assert.NoError(t, err)
+	assert.Equal(t, "?expires=1516294800&symlink=get", query)
+}
+
+func TestObjectStorageObjectV1SymlinkTarget(t *testing.T) {
+	header := http.Header{}
+	header.Set("X-Symlink-Target", "container_1/dir%20a/object_1")
+	header.Set("X-Symlink-Target-Account", "AUTH_test")
+
+	target, account := objectStorageObjectV1SymlinkTarget(header)
+	assert.Equal(t, "container_1/dir a/object_1", target)
+	assert.Equal(t, "AUTH_test", account)
+
+	target, account = objectStorageObjectV1SymlinkTarget(http.Header{})
+	assert.Equal(t, "", target)
+	assert.Equal(t, "", account)
+}
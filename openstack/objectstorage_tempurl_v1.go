@@ -0,0 +1,129 @@
+package openstack
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"fmt"
+	"hash"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/accounts"
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/containers"
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/objects"
+)
+
+// validateObjectstorageTempurlV1AllowedIP ensures the value is either an IP
+// address or a CIDR.
+func validateObjectstorageTempurlV1AllowedIP(v interface{}, k string) ([]string, []error) {
+	value := v.(string)
+
+	if net.ParseIP(value) != nil {
+		return nil, nil
+	}
+
+	if _, _, err := net.ParseCIDR(value); err == nil {
+		return nil, nil
+	}
+
+	return nil, []error{fmt.Errorf("%q must be an IP address or a CIDR, got %q", k, value)}
+}
+
+// validateObjectstorageTempurlV1Digest ensures the value is a digest
+// supported by Swift temporary urls.
+func validateObjectstorageTempurlV1Digest(v interface{}, k string) ([]string, []error) {
+	value := v.(string)
+
+	if _, ok := objectstorageTempurlV1Digests[value]; !ok {
+		return nil, []error{fmt.Errorf("%q must be one of sha1, sha256 or sha512, got %q", k, value)}
+	}
+
+	return nil, nil
+}
+
+var objectstorageTempurlV1Digests = map[string]func() hash.Hash{
+	"sha1":   sha1.New,
+	"sha256": sha256.New,
+	"sha512": sha512.New,
+}
+
+// objectstorageTempurlV1Signature returns the HMAC signature of a temporary
+// url. When allowedIP is set, the signature is IP-scoped. Swift only accepts
+// hex signatures for sha1 and sha256, sha512 ones are prefixed and base64
+// encoded.
+func objectstorageTempurlV1Signature(key, digest string, method objects.HTTPMethod, expiry int64, path, allowedIP string) string {
+	body := fmt.Sprintf("%s\n%d\n%s", method, expiry, path)
+	if allowedIP != "" {
+		body = fmt.Sprintf("ip=%s\n%s", allowedIP, body)
+	}
+
+	mac := hmac.New(objectstorageTempurlV1Digests[digest], []byte(key))
+	mac.Write([]byte(body))
+	sum := mac.Sum(nil)
+
+	if digest == "sha512" {
+		return "sha512:" + base64.RawURLEncoding.EncodeToString(sum)
+	}
+
+	return fmt.Sprintf("%x", sum)
+}
+
+// objectstorageTempurlV1Key returns the temporary url key of the container,
+// falling back to the account one.
+func objectstorageTempurlV1Key(client *gophercloud.ServiceClient, containerName string) (string, error) {
+	container, err := containers.Get(client, containerName, nil).Extract()
+	if err != nil {
+		return "", err
+	}
+
+	if container.TempURLKey != "" {
+		return container.TempURLKey, nil
+	}
+
+	account, err := accounts.Get(client, nil).Extract()
+	if err != nil {
+		return "", err
+	}
+
+	return account.TempURLKey, nil
+}
+
+// objectstorageTempurlV1Create generates a temporary url signed with the
+// digest and optionally scoped to allowedIP. gophercloud only supports sha1
+// signatures without the temp_url_ip_range parameter. When key is empty, the
+// container or account key is used.
+func objectstorageTempurlV1Create(client *gophercloud.ServiceClient, containerName, objectName string, opts objects.CreateTempURLOpts, key, allowedIP, digest string) (string, error) {
+	if opts.Split == "" {
+		opts.Split = "/v1/"
+	}
+
+	if key == "" {
+		var err error
+		key, err = objectstorageTempurlV1Key(client, containerName)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	expiry := time.Now().UTC().Add(time.Duration(opts.TTL) * time.Second).Unix()
+
+	splitPath := strings.SplitN(client.ServiceURL(containerName, objectName), opts.Split, 2)
+	if len(splitPath) != 2 {
+		return "", fmt.Errorf("Unable to split the object url with %q", opts.Split)
+	}
+	baseURL, objectPath := splitPath[0], opts.Split+splitPath[1]
+
+	sig := objectstorageTempurlV1Signature(key, digest, opts.Method, expiry, objectPath, allowedIP)
+
+	url := fmt.Sprintf("%s%s?temp_url_sig=%s&temp_url_expires=%d", baseURL, objectPath, sig, expiry)
+	if allowedIP != "" {
+		url += "&temp_url_ip_range=" + allowedIP
+	}
+
+	return url, nil
+}
@@ -0,0 +1,51 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/objects"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateObjectstorageTempurlV1AllowedIP(t *testing.T) {
+	for _, v := range []string{"1.2.3.4", "1.2.3.0/24", "2001:db8::1", "2001:db8::/32"} {
+		_, errs := validateObjectstorageTempurlV1AllowedIP(v, "allowed_ip")
+		assert.Empty(t, errs, v)
+	}
+
+	for _, v := range []string{"", "1.2.3", "1.2.3.4/33", "example.com"} {
+		_, errs := validateObjectstorageTempurlV1AllowedIP(v, "allowed_ip")
+		assert.Len(t, errs, 1, v)
+	}
+}
+
+func TestObjectstorageTempurlV1Signature(t *testing.T) {
+	sig := objectstorageTempurlV1Signature("mykey", "sha1", objects.GET, 1323479485, "/v1/AUTH_account/container/object", "1.2.3.4")
+
+	assert.Equal(t, "67127dd122b4b2fbd21796f253813b5a211d84ab", sig)
+}
+
+func TestValidateObjectstorageTempurlV1Digest(t *testing.T) {
+	for _, v := range []string{"sha1", "sha256", "sha512"} {
+		_, errs := validateObjectstorageTempurlV1Digest(v, "digest")
+		assert.Empty(t, errs, v)
+	}
+
+	for _, v := range []string{"", "md5", "SHA256"} {
+		_, errs := validateObjectstorageTempurlV1Digest(v, "digest")
+		assert.Len(t, errs, 1, v)
+	}
+}
+
+func TestObjectstorageTempurlV1Signature_digest(t *testing.T) {
+	path := "/v1/AUTH_account/container/object"
+
+	sha1Sig := objectstorageTempurlV1Signature("mykey", "sha1", objects.GET, 1323479485, path, "")
+	sha256Sig := objectstorageTempurlV1Signature("mykey", "sha256", objects.GET, 1323479485, path, "")
+	sha512Sig := objectstorageTempurlV1Signature("mykey", "sha512", objects.GET, 1323479485, path, "")
+
+	assert.Equal(t, "d9fc2067e52b06598421664cf6610bfc8fc431f6", sha1Sig)
+	assert.Equal(t, "05cb4ea08a08f2fdaef35d0f344975370077835c23bdc9342099ecbf03bc0378", sha256Sig)
+	assert.Equal(t, "sha512:zZD1u2V0ZLDxhhrRPFrYzGf1H0yIHc6Qv8kAhpMcy4mguNI8bP99Sv4C2HOwA1OF0wJUl7vX8Mo11hv5k223fQ", sha512Sig)
+	assert.NotEqual(t, sha1Sig, sha256Sig)
+}
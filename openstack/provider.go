@@ -1,10 +1,15 @@
 package openstack
 
 import (
+	"context"
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/meta"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 
+	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/utils/terraform/auth"
 	"github.com/gophercloud/utils/terraform/mutexkv"
 )
@@ -13,6 +18,17 @@ import (
 // Config struct.
 type Config struct {
 	auth.Config
+
+	// AllowCustomVNICTypes disables the validation of port binding
+	// vnic_type values, e.g. for custom mechanism drivers.
+	AllowCustomVNICTypes bool
+
+	// StopContext is canceled when Terraform is interrupted, so that
+	// long-running waits can be aborted.
+	StopContext context.Context
+
+	imageListCache       imagesImageV2ListCache
+	networkingExtensions networkingV2ExtensionCache
 }
 
 // Provider returns a schema.Provider for OpenStack.
@@ -243,6 +259,53 @@ func Provider() terraform.ResourceProvider {
 				Default:     false,
 				Description: descriptions["disable_no_cache_header"],
 			},
+
+			"max_parallel_requests": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("OS_MAX_PARALLEL_REQUESTS", 0),
+				Description:  descriptions["max_parallel_requests"],
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"max_idle_conns": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("OS_MAX_IDLE_CONNS", 0),
+				Description:  descriptions["max_idle_conns"],
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"max_idle_conns_per_host": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("OS_MAX_IDLE_CONNS_PER_HOST", 0),
+				Description:  descriptions["max_idle_conns_per_host"],
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"idle_conn_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("OS_IDLE_CONN_TIMEOUT", 0),
+				Description:  descriptions["idle_conn_timeout"],
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"request_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("OS_REQUEST_TIMEOUT", 300),
+				Description:  descriptions["request_timeout"],
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"allow_custom_vnic_types": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_ALLOW_CUSTOM_VNIC_TYPES", false),
+				Description: descriptions["allow_custom_vnic_types"],
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -251,6 +314,7 @@ func Provider() terraform.ResourceProvider {
 			"openstack_blockstorage_snapshot_v3":                 dataSourceBlockStorageSnapshotV3(),
 			"openstack_blockstorage_volume_v2":                   dataSourceBlockStorageVolumeV2(),
 			"openstack_blockstorage_volume_v3":                   dataSourceBlockStorageVolumeV3(),
+			"openstack_blockstorage_quotaset_v3":                 dataSourceBlockStorageQuotasetV3(),
 			"openstack_compute_aggregate_v2":                     dataSourceComputeAggregateV2(),
 			"openstack_compute_availability_zones_v2":            dataSourceComputeAvailabilityZonesV2(),
 			"openstack_compute_instance_v2":                      dataSourceComputeInstanceV2(),
@@ -261,6 +325,9 @@ func Provider() terraform.ResourceProvider {
 			"openstack_containerinfra_cluster_v1":                dataSourceContainerInfraCluster(),
 			"openstack_dns_zone_v2":                              dataSourceDNSZoneV2(),
 			"openstack_fw_policy_v1":                             dataSourceFWPolicyV1(),
+			"openstack_fw_group_v2":                              dataSourceFWGroupV2(),
+			"openstack_fw_policy_v2":                             dataSourceFWPolicyV2(),
+			"openstack_fw_rule_v2":                               dataSourceFWRuleV2(),
 			"openstack_identity_role_v3":                         dataSourceIdentityRoleV3(),
 			"openstack_identity_project_v3":                      dataSourceIdentityProjectV3(),
 			"openstack_identity_user_v3":                         dataSourceIdentityUserV3(),
@@ -268,9 +335,13 @@ func Provider() terraform.ResourceProvider {
 			"openstack_identity_endpoint_v3":                     dataSourceIdentityEndpointV3(),
 			"openstack_identity_service_v3":                      dataSourceIdentityServiceV3(),
 			"openstack_identity_group_v3":                        dataSourceIdentityGroupV3(),
+			"openstack_identity_limit_v3":                        dataSourceIdentityLimitV3(),
+			"openstack_identity_limit_model_v3":                  dataSourceIdentityLimitModelV3(),
+			"openstack_identity_registered_limit_v3":             dataSourceIdentityRegisteredLimitV3(),
 			"openstack_images_image_v2":                          dataSourceImagesImageV2(),
 			"openstack_images_image_ids_v2":                      dataSourceImagesImageIDsV2(),
 			"openstack_networking_addressscope_v2":               dataSourceNetworkingAddressScopeV2(),
+			"openstack_networking_availability_zones_v2":         dataSourceNetworkingAvailabilityZonesV2(),
 			"openstack_networking_network_v2":                    dataSourceNetworkingNetworkV2(),
 			"openstack_networking_qos_bandwidth_limit_rule_v2":   dataSourceNetworkingQoSBandwidthLimitRuleV2(),
 			"openstack_networking_qos_dscp_marking_rule_v2":      dataSourceNetworkingQoSDSCPMarkingRuleV2(),
@@ -280,21 +351,26 @@ func Provider() terraform.ResourceProvider {
 			"openstack_networking_subnet_ids_v2":                 dataSourceNetworkingSubnetIDsV2(),
 			"openstack_networking_secgroup_v2":                   dataSourceNetworkingSecGroupV2(),
 			"openstack_networking_subnetpool_v2":                 dataSourceNetworkingSubnetPoolV2(),
+			"openstack_networking_subnetpool_usage_v2":           dataSourceNetworkingSubnetPoolUsageV2(),
 			"openstack_networking_floatingip_v2":                 dataSourceNetworkingFloatingIPV2(),
 			"openstack_networking_router_v2":                     dataSourceNetworkingRouterV2(),
 			"openstack_networking_port_v2":                       dataSourceNetworkingPortV2(),
 			"openstack_networking_port_ids_v2":                   dataSourceNetworkingPortIDsV2(),
 			"openstack_networking_trunk_v2":                      dataSourceNetworkingTrunkV2(),
+			"openstack_objectstorage_objects_v1":                 dataSourceObjectStorageObjectsV1(),
+			"openstack_networking_quota_v2":                      dataSourceNetworkingQuotaV2(),
 			"openstack_sharedfilesystem_availability_zones_v2":   dataSourceSharedFilesystemAvailabilityZonesV2(),
 			"openstack_sharedfilesystem_sharenetwork_v2":         dataSourceSharedFilesystemShareNetworkV2(),
 			"openstack_sharedfilesystem_share_v2":                dataSourceSharedFilesystemShareV2(),
 			"openstack_sharedfilesystem_snapshot_v2":             dataSourceSharedFilesystemSnapshotV2(),
 			"openstack_keymanager_secret_v1":                     dataSourceKeyManagerSecretV1(),
 			"openstack_keymanager_container_v1":                  dataSourceKeyManagerContainerV1(),
+			"openstack_lb_loadbalancer_stats_v2":                 dataSourceLBLoadBalancerStatsV2(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
 			"openstack_blockstorage_quotaset_v2":                 resourceBlockStorageQuotasetV2(),
+			"openstack_blockstorage_quota_class_v3":              resourceBlockStorageQuotaClassV3(),
 			"openstack_blockstorage_quotaset_v3":                 resourceBlockStorageQuotasetV3(),
 			"openstack_blockstorage_volume_v1":                   resourceBlockStorageVolumeV1(),
 			"openstack_blockstorage_volume_v2":                   resourceBlockStorageVolumeV2(),
@@ -311,16 +387,19 @@ func Provider() terraform.ResourceProvider {
 			"openstack_compute_keypair_v2":                       resourceComputeKeypairV2(),
 			"openstack_compute_secgroup_v2":                      resourceComputeSecGroupV2(),
 			"openstack_compute_servergroup_v2":                   resourceComputeServerGroupV2(),
+			"openstack_compute_quota_class_v2":                   resourceComputeQuotaClassV2(),
 			"openstack_compute_quotaset_v2":                      resourceComputeQuotasetV2(),
 			"openstack_compute_floatingip_v2":                    resourceComputeFloatingIPV2(),
 			"openstack_compute_floatingip_associate_v2":          resourceComputeFloatingIPAssociateV2(),
 			"openstack_compute_volume_attach_v2":                 resourceComputeVolumeAttachV2(),
 			"openstack_containerinfra_clustertemplate_v1":        resourceContainerInfraClusterTemplateV1(),
 			"openstack_containerinfra_cluster_v1":                resourceContainerInfraClusterV1(),
+			"openstack_containerinfra_cluster_cert_rotate_v1":    resourceContainerInfraClusterCertRotateV1(),
 			"openstack_db_instance_v1":                           resourceDatabaseInstanceV1(),
 			"openstack_db_user_v1":                               resourceDatabaseUserV1(),
 			"openstack_db_configuration_v1":                      resourceDatabaseConfigurationV1(),
 			"openstack_db_database_v1":                           resourceDatabaseDatabaseV1(),
+			"openstack_dns_quota_v2":                             resourceDNSQuotaV2(),
 			"openstack_dns_recordset_v2":                         resourceDNSRecordSetV2(),
 			"openstack_dns_zone_v2":                              resourceDNSZoneV2(),
 			"openstack_dns_transfer_request_v2":                  resourceDNSTransferRequestV2(),
@@ -385,6 +464,7 @@ func Provider() terraform.ResourceProvider {
 			"openstack_vpnaas_ike_policy_v2":                     resourceIKEPolicyV2(),
 			"openstack_vpnaas_endpoint_group_v2":                 resourceEndpointGroupV2(),
 			"openstack_vpnaas_site_connection_v2":                resourceSiteConnectionV2(),
+			"openstack_sharedfilesystem_quota_v2":                resourceSharedFilesystemQuotaV2(),
 			"openstack_sharedfilesystem_securityservice_v2":      resourceSharedFilesystemSecurityServiceV2(),
 			"openstack_sharedfilesystem_sharenetwork_v2":         resourceSharedFilesystemShareNetworkV2(),
 			"openstack_sharedfilesystem_share_v2":                resourceSharedFilesystemShareV2(),
@@ -402,7 +482,7 @@ func Provider() terraform.ResourceProvider {
 			// We can therefore assume that if it's missing it's 0.10 or 0.11
 			terraformVersion = "0.11+compatible"
 		}
-		return configureProvider(d, terraformVersion)
+		return configureProvider(d, terraformVersion, provider.StopContext())
 	}
 
 	return provider
@@ -480,12 +560,31 @@ func init() {
 			"automatically, if the initial auth token get expired. Defaults to `true`",
 
 		"max_retries": "How many times HTTP connection should be retried until giving up.",
+
+		"max_parallel_requests": "The maximum number of concurrent API requests. Requests\n" +
+			"beyond the limit wait for a free slot. Defaults to `0`, no limit.",
+
+		"max_idle_conns": "The maximum number of idle HTTP connections kept open.\n" +
+			"Defaults to `0`, the Go default.",
+
+		"max_idle_conns_per_host": "The maximum number of idle HTTP connections kept open\n" +
+			"per host. Defaults to `0`, the Go default.",
+
+		"idle_conn_timeout": "The number of seconds an idle HTTP connection is kept open.\n" +
+			"Defaults to `0`, the Go default.",
+
+		"request_timeout": "The number of seconds a single API request may take, independent\n" +
+			"of the resource timeouts. Image and object uploads are excluded. `0` disables\n" +
+			"the timeout. Defaults to `300`.",
+
+		"allow_custom_vnic_types": "If set to `true`, the port binding `vnic_type` values\n" +
+			"are not validated. Useful for custom mechanism drivers.",
 	}
 }
 
-func configureProvider(d *schema.ResourceData, terraformVersion string) (interface{}, error) {
+func configureProvider(d *schema.ResourceData, terraformVersion string, stopCtx context.Context) (interface{}, error) {
 	config := Config{
-		auth.Config{
+		Config: auth.Config{
 			CACertFile:                  d.Get("cacert_file").(string),
 			ClientCertFile:              d.Get("cert").(string),
 			ClientKeyFile:               d.Get("key").(string),
@@ -520,6 +619,8 @@ func configureProvider(d *schema.ResourceData, terraformVersion string) (interfa
 			SDKVersion:                  meta.SDKVersionString(),
 			MutexKV:                     mutexkv.NewMutexKV(),
 		},
+		AllowCustomVNICTypes: d.Get("allow_custom_vnic_types").(bool),
+		StopContext:          stopCtx,
 	}
 
 	v, ok := d.GetOkExists("insecure")
@@ -528,9 +629,58 @@ func configureProvider(d *schema.ResourceData, terraformVersion string) (interfa
 		config.Insecure = &insecure
 	}
 
+	// Postpone the authentication until the HTTP client options are applied,
+	// so that the version discovery and the token request use them as well.
+	delayedAuth := config.DelayedAuth
+	config.DelayedAuth = true
+
 	if err := config.LoadAndValidate(); err != nil {
 		return nil, err
 	}
 
+	if config.OsClient != nil {
+		if err := configureProviderHTTPClient(d, config.OsClient); err != nil {
+			return nil, err
+		}
+	}
+
+	if !delayedAuth && !config.Swauth {
+		if err := config.Authenticate(); err != nil {
+			return nil, err
+		}
+	}
+	config.DelayedAuth = delayedAuth
+
 	return &config, nil
 }
+
+// configureProviderHTTPClient applies the HTTP client options of the provider
+// to the gophercloud provider client.
+func configureProviderHTTPClient(d *schema.ResourceData, client *gophercloud.ProviderClient) error {
+	transportOpts := httpTransportOpts{
+		MaxIdleConns:        d.Get("max_idle_conns").(int),
+		MaxIdleConnsPerHost: d.Get("max_idle_conns_per_host").(int),
+		IdleConnTimeout:     time.Duration(d.Get("idle_conn_timeout").(int)) * time.Second,
+	}
+
+	if transportOpts != (httpTransportOpts{}) {
+		if err := configureHTTPTransport(client.HTTPClient.Transport, transportOpts); err != nil {
+			return err
+		}
+	}
+
+	client.HTTPClient.Transport = &versionChoicesRoundTripper{rt: client.HTTPClient.Transport}
+
+	// The timeout covers a single attempt of an HTTP request, including
+	// reading the response body, while the resource timeouts cover the wait
+	// loops.
+	if v := d.Get("request_timeout").(int); v > 0 {
+		client.HTTPClient.Transport = withRequestTimeout(client.HTTPClient.Transport, time.Duration(v)*time.Second)
+	}
+
+	if v := d.Get("max_parallel_requests").(int); v > 0 {
+		client.HTTPClient.Transport = newSemaphoreRoundTripper(client.HTTPClient.Transport, v)
+	}
+
+	return nil
+}
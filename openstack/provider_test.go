@@ -1,15 +1,23 @@
 package openstack
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
+	"net/http"
 	"os"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/pathorcontents"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/stretchr/testify/assert"
 
+	"github.com/gophercloud/gophercloud"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	osClient "github.com/gophercloud/utils/client"
 	"github.com/gophercloud/utils/terraform/auth"
 	"github.com/gophercloud/utils/terraform/mutexkv"
 )
@@ -32,6 +40,7 @@ var (
 	osSwiftEnvironment               = os.Getenv("OS_SWIFT_ENVIRONMENT")
 	osLbEnvironment                  = os.Getenv("OS_LB_ENVIRONMENT")
 	osFwEnvironment                  = os.Getenv("OS_FW_ENVIRONMENT")
+	osFwV2Environment                = os.Getenv("OS_FWV2_ENVIRONMENT")
 	osVpnEnvironment                 = os.Getenv("OS_VPN_ENVIRONMENT")
 	osUseOctavia                     = os.Getenv("OS_USE_OCTAVIA")
 	osOctaviaBatchMembersEnvironment = os.Getenv("OS_OCTAVIA_BATCH_MEMBERS_ENVIRONMENT")
@@ -45,6 +54,11 @@ var (
 	osHypervisorEnvironment      = os.Getenv("OS_HYPERVISOR_HOSTNAME")
 	osPortForwardingEnvironment  = os.Getenv("OS_PORT_FORWARDING_ENVIRONMENT")
 	osBlockStorageV2             = os.Getenv("OS_BLOCKSTORAGE_V2")
+	osDeviceProfileName          = os.Getenv("OS_DEVICE_PROFILE_NAME")
+	osLbTLSContainerRef          = os.Getenv("OS_LB_TLS_CONTAINER_REF")
+	osLbClientCAContainerRef     = os.Getenv("OS_LB_CLIENT_CA_CONTAINER_REF")
+	osNetworkingAZEnvironment    = os.Getenv("OS_NETWORKING_AZ_ENVIRONMENT")
+	osDVREnvironment             = os.Getenv("OS_DVR_ENVIRONMENT")
 )
 
 var testAccProviders map[string]terraform.ResourceProvider
@@ -165,6 +179,14 @@ func testAccPreCheckFW(t *testing.T) {
 	}
 }
 
+func testAccPreCheckFWV2(t *testing.T) {
+	testAccPreCheckRequiredEnvVars(t)
+
+	if osFwV2Environment == "" {
+		t.Skip("This environment does not support FWaaS v2 tests")
+	}
+}
+
 func testAccPreCheckVPN(t *testing.T) {
 	testAccPreCheckRequiredEnvVars(t)
 
@@ -229,6 +251,38 @@ func testAccPreCheckPortForwarding(t *testing.T) {
 	}
 }
 
+func testAccPreCheckDVR(t *testing.T) {
+	testAccPreCheckRequiredEnvVars(t)
+
+	if osDVREnvironment == "" {
+		t.Skip("This environment does not support 'dvr' extension tests")
+	}
+}
+
+func testAccPreCheckDeviceProfile(t *testing.T) {
+	testAccPreCheckRequiredEnvVars(t)
+
+	if osDeviceProfileName == "" {
+		t.Skip("OS_DEVICE_PROFILE_NAME is required for 'port-device-profile' extension tests")
+	}
+}
+
+func testAccPreCheckLBTLS(t *testing.T) {
+	testAccPreCheckRequiredEnvVars(t)
+
+	if osLbTLSContainerRef == "" {
+		t.Skip("OS_LB_TLS_CONTAINER_REF is required for TERMINATED_HTTPS listener tests")
+	}
+}
+
+func testAccPreCheckLBClientCA(t *testing.T) {
+	testAccPreCheckLBTLS(t)
+
+	if osLbClientCAContainerRef == "" {
+		t.Skip("OS_LB_CLIENT_CA_CONTAINER_REF is required for listener client authentication tests")
+	}
+}
+
 func testAccPreCheckAdminOnly(t *testing.T) {
 	v := os.Getenv("OS_USERNAME")
 	if v != "admin" {
@@ -255,6 +309,12 @@ func testAccPreCheckHypervisor(t *testing.T) {
 	}
 }
 
+func testAccPreCheckNetworkingAvailabilityZones(t *testing.T) {
+	if osNetworkingAZEnvironment == "" {
+		t.Skip("This environment does not support Neutron availability zone tests")
+	}
+}
+
 func TestProvider(t *testing.T) {
 	if err := Provider().(*schema.Provider).InternalValidate(); err != nil {
 		t.Fatalf("err: %s", err)
@@ -265,6 +325,162 @@ func TestProvider_impl(t *testing.T) {
 	var _ terraform.ResourceProvider = Provider()
 }
 
+func TestProvider_reauth(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	var tokenRequests, serverRequests int32
+
+	th.Mux.HandleFunc("/v3/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		n := atomic.AddInt32(&tokenRequests, 1)
+
+		w.Header().Add("X-Subject-Token", fmt.Sprintf("token-%d", n))
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"token": {"expires_at": "%s", "catalog": []}}`,
+			time.Now().Add(time.Hour).UTC().Format(time.RFC3339))
+	})
+
+	th.Mux.HandleFunc("/servers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		atomic.AddInt32(&serverRequests, 1)
+
+		// The first token is expired, the refreshed one is accepted.
+		if r.Header.Get("X-Auth-Token") == "token-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		th.TestHeader(t, r, "X-Auth-Token", "token-2")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	config := Config{
+		Config: auth.Config{
+			IdentityEndpoint: th.Endpoint() + "v3/",
+			Username:         "admin",
+			Password:         "secret",
+			UserDomainName:   "Default",
+			TenantName:       "admin",
+			DomainName:       "Default",
+			AllowReauth:      true,
+			DelayedAuth:      false,
+			MutexKV:          mutexkv.NewMutexKV(),
+		},
+	}
+
+	if err := config.LoadAndValidate(); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := config.OsClient.Request("GET", th.Endpoint()+"servers", &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&tokenRequests))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&serverRequests))
+}
+
+func TestConfigureProvider_versionChoices(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	var tokenRequests int32
+
+	// The version discovery advertises an internal endpoint, which is only
+	// reachable when the provider rewrites it before authenticating.
+	th.Mux.HandleFunc("/identity/", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusMultipleChoices)
+		fmt.Fprint(w, `{"versions": {"values": [{"id": "v3.14", "status": "stable", "links": [{"rel": "self", "href": "http://10.0.0.1:5000/v3/"}]}]}}`)
+	})
+
+	th.Mux.HandleFunc("/identity/v3/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		atomic.AddInt32(&tokenRequests, 1)
+
+		w.Header().Add("X-Subject-Token", "token")
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"token": {"expires_at": "%s", "catalog": []}}`,
+			time.Now().Add(time.Hour).UTC().Format(time.RFC3339))
+	})
+
+	d := schema.TestResourceDataRaw(t, Provider().(*schema.Provider).Schema, map[string]interface{}{
+		"auth_url":         th.Endpoint() + "identity/",
+		"user_name":        "admin",
+		"password":         "secret",
+		"user_domain_name": "Default",
+		"tenant_name":      "admin",
+		"domain_name":      "Default",
+		"delayed_auth":     false,
+		"request_timeout":  5,
+	})
+
+	meta, err := configureProvider(d, "", context.Background())
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	config := meta.(*Config)
+	assert.False(t, config.DelayedAuth)
+	assert.Equal(t, "token", config.OsClient.TokenID)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&tokenRequests))
+}
+
+func TestConfigureProviderHTTPClient(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, Provider().(*schema.Provider).Schema, map[string]interface{}{
+		"request_timeout": 30,
+	})
+
+	client := &gophercloud.ProviderClient{}
+	client.HTTPClient.Transport = &osClient.RoundTripper{Rt: &http.Transport{}}
+
+	assert.NoError(t, configureProviderHTTPClient(d, client))
+	assert.Equal(t, time.Duration(0), client.HTTPClient.Timeout)
+
+	// The timeout is applied to every attempt of the retrying round tripper.
+	rt := client.HTTPClient.Transport.(*versionChoicesRoundTripper).rt.(*osClient.RoundTripper)
+	if assert.IsType(t, &requestTimeoutRoundTripper{}, rt.Rt) {
+		assert.Equal(t, 30*time.Second, rt.Rt.(*requestTimeoutRoundTripper).timeout)
+	}
+}
+
+func TestConfigureProviderHTTPClient_requestTimeout(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	done := make(chan struct{})
+	defer close(done)
+
+	th.Mux.HandleFunc("/slow", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	d := schema.TestResourceDataRaw(t, Provider().(*schema.Provider).Schema, map[string]interface{}{
+		"request_timeout": 1,
+	})
+
+	client := &gophercloud.ProviderClient{}
+	client.HTTPClient.Transport = &http.Transport{}
+
+	assert.NoError(t, configureProviderHTTPClient(d, client))
+
+	start := time.Now()
+	_, err := client.Request("GET", th.Endpoint()+"slow", &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	assert.Error(t, err)
+	assert.Less(t, int64(time.Since(start)), int64(5*time.Second))
+}
+
 // Steps for configuring OpenStack with SSL validation are here:
 // https://github.com/hashicorp/terraform/pull/6279#issuecomment-219020144
 func TestAccProvider_caCertFile(t *testing.T) {
@@ -420,7 +636,7 @@ func testAccAuthFromEnv() (*Config, error) {
 	}
 
 	config := Config{
-		auth.Config{
+		Config: auth.Config{
 			CACertFile:        os.Getenv("OS_CACERT"),
 			ClientCertFile:    os.Getenv("OS_CERT"),
 			ClientKeyFile:     os.Getenv("OS_KEY"),
@@ -0,0 +1,99 @@
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// The compute and the block storage quota class APIs share the same
+// os-quota-class-sets format.
+
+// quotaClassGet retrieves the quotas of a quota class.
+func quotaClassGet(client *gophercloud.ServiceClient, className string) (map[string]interface{}, error) {
+	var r gophercloud.Result
+	_, r.Err = client.Get(client.ServiceURL("os-quota-class-sets", className), &r.Body, nil)
+
+	var s struct {
+		QuotaClassSet map[string]interface{} `json:"quota_class_set"`
+	}
+	err := r.ExtractInto(&s)
+
+	return s.QuotaClassSet, err
+}
+
+// quotaClassUpdate updates the quotas of a quota class.
+func quotaClassUpdate(client *gophercloud.ServiceClient, className string, quotas map[string]int) error {
+	b := map[string]interface{}{
+		"quota_class_set": quotas,
+	}
+
+	var r gophercloud.Result
+	_, r.Err = client.Put(client.ServiceURL("os-quota-class-sets", className), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	return r.Err
+}
+
+// quotaClassSchema returns the schema of a quota class resource with the
+// given quota fields.
+func quotaClassSchema(fields []string) map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"region": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+		},
+
+		"class_name": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ForceNew: true,
+			Default:  "default",
+		},
+	}
+
+	for _, field := range fields {
+		s[field] = &schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		}
+	}
+
+	return s
+}
+
+// expandQuotaClassCreate returns the configured quotas of a quota class.
+func expandQuotaClassCreate(d *schema.ResourceData, fields []string) map[string]int {
+	quotas := make(map[string]int)
+	for _, field := range fields {
+		if v, ok := d.GetOkExists(field); ok {
+			quotas[field] = v.(int)
+		}
+	}
+
+	return quotas
+}
+
+// expandQuotaClassUpdate returns the changed quotas of a quota class.
+func expandQuotaClassUpdate(d *schema.ResourceData, fields []string) map[string]int {
+	quotas := make(map[string]int)
+	for _, field := range fields {
+		if d.HasChange(field) {
+			quotas[field] = d.Get(field).(int)
+		}
+	}
+
+	return quotas
+}
+
+// flattenQuotaClass sets the quotas of a quota class.
+func flattenQuotaClass(d *schema.ResourceData, fields []string, quotas map[string]interface{}) {
+	for _, field := range fields {
+		if v, ok := quotas[field].(float64); ok {
+			d.Set(field, int(v))
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+var blockStorageQuotaClassV3Fields = []string{
+	"volumes",
+	"snapshots",
+	"gigabytes",
+	"per_volume_gigabytes",
+	"backups",
+	"backup_gigabytes",
+	"groups",
+}
+
+func resourceBlockStorageQuotaClassV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBlockStorageQuotaClassV3Create,
+		Read:   resourceBlockStorageQuotaClassV3Read,
+		Update: resourceBlockStorageQuotaClassV3Update,
+		Delete: schema.RemoveFromState,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: quotaClassSchema(blockStorageQuotaClassV3Fields),
+	}
+}
+
+func resourceBlockStorageQuotaClassV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	blockStorageClient, err := config.BlockStorageV3Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	className := d.Get("class_name").(string)
+	quotas := expandQuotaClassCreate(d, blockStorageQuotaClassV3Fields)
+
+	log.Printf("[DEBUG] openstack_blockstorage_quota_class_v3 %s create options: %#v", className, quotas)
+
+	if len(quotas) > 0 {
+		err = quotaClassUpdate(blockStorageClient, className, quotas)
+		if err != nil {
+			return fmt.Errorf("Error creating openstack_blockstorage_quota_class_v3 %s: %s", className, err)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", className, region))
+
+	return resourceBlockStorageQuotaClassV3Read(d, meta)
+}
+
+func resourceBlockStorageQuotaClassV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	blockStorageClient, err := config.BlockStorageV3Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	className := strings.Split(d.Id(), "/")[0]
+
+	quotas, err := quotaClassGet(blockStorageClient, className)
+	if err != nil {
+		return CheckDeleted(d, err, "Error retrieving openstack_blockstorage_quota_class_v3")
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_blockstorage_quota_class_v3 %s: %#v", d.Id(), quotas)
+
+	d.Set("class_name", className)
+	d.Set("region", region)
+	flattenQuotaClass(d, blockStorageQuotaClassV3Fields, quotas)
+
+	return nil
+}
+
+func resourceBlockStorageQuotaClassV3Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.BlockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	className := d.Get("class_name").(string)
+	quotas := expandQuotaClassUpdate(d, blockStorageQuotaClassV3Fields)
+
+	if len(quotas) > 0 {
+		log.Printf("[DEBUG] openstack_blockstorage_quota_class_v3 %s update options: %#v", d.Id(), quotas)
+		err = quotaClassUpdate(blockStorageClient, className, quotas)
+		if err != nil {
+			return fmt.Errorf("Error updating openstack_blockstorage_quota_class_v3 %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceBlockStorageQuotaClassV3Read(d, meta)
+}
@@ -0,0 +1,46 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccBlockStorageQuotaClassV3_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBlockStorageQuotaClassV3Basic(20),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_quota_class_v3.quota_class_1", "class_name", "default"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_quota_class_v3.quota_class_1", "volumes", "20"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_blockstorage_quota_class_v3.quota_class_1", "gigabytes"),
+				),
+			},
+			{
+				Config: testAccBlockStorageQuotaClassV3Basic(10),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_quota_class_v3.quota_class_1", "volumes", "10"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBlockStorageQuotaClassV3Basic(volumes int) string {
+	return fmt.Sprintf(`
+resource "openstack_blockstorage_quota_class_v3" "quota_class_1" {
+  volumes = %d
+}
+`, volumes)
+}
@@ -3,7 +3,6 @@ package openstack
 import (
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
 	"github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/quotasets"
@@ -128,8 +127,7 @@ func resourceBlockStorageQuotasetV2Create(d *schema.ResourceData, meta interface
 		return fmt.Errorf("Error creating openstack_blockstorage_quotaset_v2: %s", err)
 	}
 
-	id := fmt.Sprintf("%s/%s", projectID, region)
-	d.SetId(id)
+	d.SetId(blockStorageQuotasetID(projectID, region))
 
 	log.Printf("[DEBUG] Created openstack_blockstorage_quotaset_v2 %#v", q)
 
@@ -144,10 +142,7 @@ func resourceBlockStorageQuotasetV2Read(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
 	}
 
-	// Depending on the provider version the resource was created, the resource id
-	// can be either <project_id> or <project_id>/<region>. This parses the project_id
-	// in both cases
-	projectID := strings.Split(d.Id(), "/")[0]
+	projectID := blockStorageQuotasetProjectID(d.Id())
 
 	q, err := quotasets.Get(blockStorageClient, projectID).Extract()
 	if err != nil {
@@ -233,16 +228,26 @@ func resourceBlockStorageQuotasetV2Update(d *schema.ResourceData, meta interface
 	}
 
 	if d.HasChange("volume_type_quota") {
-		volumeTypeQuotaRaw := d.Get("volume_type_quota").(map[string]interface{})
-
-		// if len(volumeTypeQuotaRaw) == 0 it can lead to error when trying to do an update with
-		// zero attributes. Not updating when a user removes all attributes is acceptable
-		// as this attributes are not removed anyways.
-		if len(volumeTypeQuotaRaw) > 0 {
-			volumeTypeQuota, err := blockStorageVolumeTypeQuotaConversion(volumeTypeQuotaRaw)
-			if err != nil {
-				return fmt.Errorf("Error parsing volume_type_quota in openstack_blockstorage_quotaset_v2: %s", err)
-			}
+		o, n := d.GetChange("volume_type_quota")
+		projectID := d.Get("project_id").(string)
+
+		// Removed entries are reset to their defaults, since Cinder doesn't
+		// remove volume type quotas.
+		volumeTypeQuota, err := blockStorageVolumeTypeQuotaUpdate(
+			o.(map[string]interface{}), n.(map[string]interface{}),
+			func() (map[string]interface{}, error) {
+				defaults, err := quotasets.GetDefaults(blockStorageClient, projectID).Extract()
+				if err != nil {
+					return nil, fmt.Errorf("Error retrieving the default quotas: %s", err)
+				}
+				return defaults.Extra, nil
+			})
+		if err != nil {
+			return fmt.Errorf("Error parsing volume_type_quota in openstack_blockstorage_quotaset_v2: %s", err)
+		}
+
+		// An update with zero attributes leads to an error.
+		if len(volumeTypeQuota) > 0 {
 			updateOpts.Extra = volumeTypeQuota
 			hasChange = true
 		}
@@ -102,6 +102,8 @@ func TestAccBlockStorageQuotasetV3_basic(t *testing.T) {
 						"openstack_blockstorage_quotaset_v3.quotaset_1", "volume_type_quota.%", "1"),
 					resource.TestCheckResourceAttr(
 						"openstack_blockstorage_quotaset_v3.quotaset_1", "volume_type_quota.volumes_foo", "10"),
+					testAccCheckBlockStorageQuotasetV3VolumeTypeQuota(&quotaset, "snapshots_foo", -1),
+					testAccCheckBlockStorageQuotasetV3VolumeTypeQuota(&quotaset, "gigabytes_foo", -1),
 				),
 			},
 		},
@@ -142,6 +144,21 @@ func testAccCheckBlockStorageQuotasetV3Exists(n string, quotaset *quotasets.Quot
 	}
 }
 
+func testAccCheckBlockStorageQuotasetV3VolumeTypeQuota(quotaset *quotasets.QuotaSet, key string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		v, ok := quotaset.Extra[key].(float64)
+		if !ok {
+			return fmt.Errorf("Volume type quota %s not found", key)
+		}
+
+		if int(v) != expected {
+			return fmt.Errorf("Volume type quota %s is %d instead of %d", key, int(v), expected)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckBlockStorageQuotasetV3Destroy(s *terraform.State) error {
 	err := testAccCheckIdentityV3ProjectDestroy(s)
 	if err != nil {
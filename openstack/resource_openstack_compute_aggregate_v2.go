@@ -86,7 +86,7 @@ func resourceComputeAggregateV2Create(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error setting metadata: %s", err)
 	}
 
-	return nil
+	return resourceComputeAggregateV2Read(d, meta)
 }
 
 func resourceComputeAggregateV2Read(d *schema.ResourceData, meta interface{}) error {
@@ -103,7 +103,7 @@ func resourceComputeAggregateV2Read(d *schema.ResourceData, meta interface{}) er
 
 	aggregate, err := aggregates.Get(computeClient, id).Extract()
 	if err != nil {
-		return fmt.Errorf("Error getting host aggregate: %s", err)
+		return CheckDeleted(d, err, "Error getting host aggregate")
 	}
 
 	// Metadata is redundant with Availability Zone
@@ -180,7 +180,7 @@ func resourceComputeAggregateV2Update(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	return nil
+	return resourceComputeAggregateV2Read(d, meta)
 }
 
 func resourceComputeAggregateV2Delete(d *schema.ResourceData, meta interface{}) error {
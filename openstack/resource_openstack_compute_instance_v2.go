@@ -176,10 +176,9 @@ func resourceComputeInstanceV2() *schema.Resource {
 							Computed: true,
 						},
 						"floating_ip": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Computed: true,
-							Removed:  "Use the openstack_compute_floatingip_associate_v2 resource instead",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.SingleIP(),
 						},
 						"mac": {
 							Type:     schema.TypeString,
@@ -600,6 +599,10 @@ func resourceComputeInstanceV2Create(d *schema.ResourceData, meta interface{}) e
 			server.ID, err)
 	}
 
+	if err := computeV2InstanceUpdateFloatingIPs(d, meta, nil, d.Get("network").([]interface{})); err != nil {
+		return err
+	}
+
 	vmState := d.Get("power_state").(string)
 	if strings.ToLower(vmState) == "shutoff" {
 		err = startstop.Stop(computeClient, d.Id()).ExtractErr()
@@ -1005,6 +1008,13 @@ func resourceComputeInstanceV2Update(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
+	if d.HasChange("network") {
+		oldNetworks, newNetworks := d.GetChange("network")
+		if err := computeV2InstanceUpdateFloatingIPs(d, meta, oldNetworks.([]interface{}), newNetworks.([]interface{})); err != nil {
+			return err
+		}
+	}
+
 	// Perform any required updates to the tags.
 	if d.HasChange("tags") {
 		instanceTags := computeV2InstanceUpdateTags(d)
@@ -1047,6 +1057,13 @@ func resourceComputeInstanceV2Delete(d *schema.ResourceData, meta interface{}) e
 			}
 		}
 	}
+	// Floating IPs associated with a port which outlives the instance would
+	// otherwise stay associated. Only floating IPs on ports of the instance
+	// are disassociated, so this runs before the ports are detached.
+	if err := computeV2InstanceUpdateFloatingIPs(d, meta, d.Get("network").([]interface{}), nil); err != nil {
+		log.Printf("[WARN] Unable to disassociate openstack_compute_instance_v2 %s floating IPs: %s", d.Id(), err)
+	}
+
 	vendorOptionsRaw := d.Get("vendor_options").(*schema.Set)
 	var detachPortBeforeDestroy bool
 	if vendorOptionsRaw.Len() > 0 {
@@ -1075,6 +1092,7 @@ func resourceComputeInstanceV2Delete(d *schema.ResourceData, meta interface{}) e
 			}
 		}
 	}
+
 	if d.Get("force_delete").(bool) {
 		log.Printf("[DEBUG] Force deleting OpenStack Instance %s", d.Id())
 		err = servers.ForceDelete(computeClient, d.Id()).ExtractErr()
@@ -14,6 +14,7 @@ import (
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/secgroups"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/volumeattach"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/floatingips"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/networks"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
 	"github.com/gophercloud/gophercloud/pagination"
@@ -473,6 +474,42 @@ func TestAccComputeV2Instance_changeFixedIP(t *testing.T) {
 	})
 }
 
+func TestAccComputeV2Instance_floatingIP(t *testing.T) {
+	var instance servers.Server
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2InstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeV2InstanceFloatingIP(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists(
+						"openstack_compute_instance_v2.instance_1", &instance),
+					testAccCheckComputeV2InstanceFloatingIPAssociated(
+						"openstack_networking_floatingip_v2.fip_1", &instance, true),
+					resource.TestCheckResourceAttrPair(
+						"openstack_compute_instance_v2.instance_1", "network.0.floating_ip",
+						"openstack_networking_floatingip_v2.fip_1", "address"),
+				),
+			},
+			{
+				Config: testAccComputeV2InstanceFloatingIP(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceFloatingIPAssociated(
+						"openstack_networking_floatingip_v2.fip_1", &instance, false),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_v2.instance_1", "network.0.floating_ip", ""),
+				),
+			},
+		},
+	})
+}
+
 func TestAccComputeV2Instance_stopBeforeDestroy(t *testing.T) {
 	var instance servers.Server
 	resource.Test(t, resource.TestCase{
@@ -996,6 +1033,49 @@ func testAccCheckComputeV2InstanceNetworkDoesNotExist(n string, instance *server
 	}
 }
 
+func testAccCheckComputeV2InstanceFloatingIPAssociated(
+	n string, instance *servers.Server, associated bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.NetworkingV2Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+		}
+
+		fip, err := floatingips.Get(networkingClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if !associated {
+			if fip.PortID != "" {
+				return fmt.Errorf("Floating IP %s is still associated with port %s", fip.FloatingIP, fip.PortID)
+			}
+			return nil
+		}
+
+		if fip.PortID == "" {
+			return fmt.Errorf("Floating IP %s is not associated", fip.FloatingIP)
+		}
+
+		port, err := ports.Get(networkingClient, fip.PortID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if port.DeviceID != instance.ID {
+			return fmt.Errorf("Floating IP %s is associated with %s, expected %s", fip.FloatingIP, port.DeviceID, instance.ID)
+		}
+
+		return nil
+	}
+}
+
 func testAccComputeV2InstanceBasic() string {
 	return fmt.Sprintf(`
 resource "openstack_compute_instance_v2" "instance_1" {
@@ -1387,6 +1467,27 @@ resource "openstack_compute_instance_v2" "instance_1" {
 `, osNetworkID)
 }
 
+func testAccComputeV2InstanceFloatingIP(associate bool) string {
+	var floatingIP string
+	if associate {
+		floatingIP = "floating_ip = \"${openstack_networking_floatingip_v2.fip_1.address}\""
+	}
+
+	return fmt.Sprintf(`
+resource "openstack_networking_floatingip_v2" "fip_1" {
+}
+
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+  network {
+    uuid = "%s"
+    %s
+  }
+}
+`, osNetworkID, floatingIP)
+}
+
 func testAccComputeV2InstanceStopBeforeDestroy() string {
 	return fmt.Sprintf(`
 resource "openstack_compute_instance_v2" "instance_1" {
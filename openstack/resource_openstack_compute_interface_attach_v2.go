@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 )
 
 func resourceComputeInterfaceAttachV2() *schema.Resource {
@@ -61,6 +62,7 @@ func resourceComputeInterfaceAttachV2() *schema.Resource {
 				Computed:      true,
 				ForceNew:      true,
 				ConflictsWith: []string{"port_id"},
+				ValidateFunc:  validation.SingleIP(),
 			},
 		},
 	}
@@ -91,8 +93,12 @@ func resourceComputeInterfaceAttachV2Create(d *schema.ResourceData, meta interfa
 
 	// For some odd reason the API takes an array of IPs, but you can only have one element in the array.
 	var fixedIPs []attachinterfaces.FixedIP
-	if v, ok := d.GetOk("fixed_ip"); ok {
-		fixedIPs = append(fixedIPs, attachinterfaces.FixedIP{IPAddress: v.(string)})
+	fixedIP := d.Get("fixed_ip").(string)
+	if fixedIP != "" {
+		if networkID == "" {
+			return fmt.Errorf("fixed_ip requires network_id to be set")
+		}
+		fixedIPs = append(fixedIPs, attachinterfaces.FixedIP{IPAddress: fixedIP})
 	}
 
 	attachOpts := attachinterfaces.CreateOpts{
@@ -111,7 +117,7 @@ func resourceComputeInterfaceAttachV2Create(d *schema.ResourceData, meta interfa
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"ATTACHING"},
 		Target:     []string{"ATTACHED"},
-		Refresh:    computeInterfaceAttachV2AttachFunc(computeClient, instanceID, attachment.PortID),
+		Refresh:    computeInterfaceAttachV2AttachFunc(computeClient, instanceID, attachment.PortID, fixedIP),
 		Timeout:    d.Timeout(schema.TimeoutCreate),
 		Delay:      5 * time.Second,
 		MinTimeout: 5 * time.Second,
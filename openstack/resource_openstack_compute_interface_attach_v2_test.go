@@ -47,6 +47,9 @@ func TestAccComputeV2InterfaceAttach_IP(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckComputeV2InterfaceAttachExists("openstack_compute_interface_attach_v2.ai_1", &ai),
 					testAccCheckComputeV2InterfaceAttachIP(&ai, "192.168.1.100"),
+					testAccCheckComputeV2InterfaceAttachListed("openstack_compute_interface_attach_v2.ai_1", "192.168.1.100"),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_interface_attach_v2.ai_1", "fixed_ip", "192.168.1.100"),
 				),
 			},
 		},
@@ -129,6 +132,42 @@ func testAccCheckComputeV2InterfaceAttachIP(
 	}
 }
 
+func testAccCheckComputeV2InterfaceAttachListed(n, ip string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		computeClient, err := config.ComputeV2Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+		}
+
+		instanceID, portID, err := computeInterfaceAttachV2ParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		allPages, err := attachinterfaces.List(computeClient, instanceID).AllPages()
+		if err != nil {
+			return err
+		}
+
+		allInterfaces, err := attachinterfaces.ExtractInterfaces(allPages)
+		if err != nil {
+			return err
+		}
+
+		if _, ok := computeInterfaceAttachV2FindInterface(allInterfaces, portID, ip); !ok {
+			return fmt.Errorf("Port %s with ip %s is not listed on instance %s", portID, ip, instanceID)
+		}
+
+		return nil
+	}
+}
+
 func testAccComputeV2InterfaceAttachBasic() string {
 	return fmt.Sprintf(`
 resource "openstack_networking_port_v2" "port_1" {
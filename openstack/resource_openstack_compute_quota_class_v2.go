@@ -0,0 +1,106 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+var computeQuotaClassV2Fields = []string{
+	"cores",
+	"instances",
+	"ram",
+	"key_pairs",
+	"metadata_items",
+	"injected_files",
+	"injected_file_content_bytes",
+	"injected_file_path_bytes",
+	"server_groups",
+	"server_group_members",
+}
+
+func resourceComputeQuotaClassV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeQuotaClassV2Create,
+		Read:   resourceComputeQuotaClassV2Read,
+		Update: resourceComputeQuotaClassV2Update,
+		Delete: schema.RemoveFromState,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: quotaClassSchema(computeQuotaClassV2Fields),
+	}
+}
+
+func resourceComputeQuotaClassV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	computeClient, err := config.ComputeV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	className := d.Get("class_name").(string)
+	quotas := expandQuotaClassCreate(d, computeQuotaClassV2Fields)
+
+	log.Printf("[DEBUG] openstack_compute_quota_class_v2 %s create options: %#v", className, quotas)
+
+	if len(quotas) > 0 {
+		err = quotaClassUpdate(computeClient, className, quotas)
+		if err != nil {
+			return fmt.Errorf("Error creating openstack_compute_quota_class_v2 %s: %s", className, err)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", className, region))
+
+	return resourceComputeQuotaClassV2Read(d, meta)
+}
+
+func resourceComputeQuotaClassV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	computeClient, err := config.ComputeV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	className := strings.Split(d.Id(), "/")[0]
+
+	quotas, err := quotaClassGet(computeClient, className)
+	if err != nil {
+		return CheckDeleted(d, err, "Error retrieving openstack_compute_quota_class_v2")
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_compute_quota_class_v2 %s: %#v", d.Id(), quotas)
+
+	d.Set("class_name", className)
+	d.Set("region", region)
+	flattenQuotaClass(d, computeQuotaClassV2Fields, quotas)
+
+	return nil
+}
+
+func resourceComputeQuotaClassV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.ComputeV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	className := d.Get("class_name").(string)
+	quotas := expandQuotaClassUpdate(d, computeQuotaClassV2Fields)
+
+	if len(quotas) > 0 {
+		log.Printf("[DEBUG] openstack_compute_quota_class_v2 %s update options: %#v", d.Id(), quotas)
+		err = quotaClassUpdate(computeClient, className, quotas)
+		if err != nil {
+			return fmt.Errorf("Error updating openstack_compute_quota_class_v2 %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceComputeQuotaClassV2Read(d, meta)
+}
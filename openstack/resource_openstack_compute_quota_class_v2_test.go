@@ -0,0 +1,46 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccComputeQuotaClassV2_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeQuotaClassV2Basic(40),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_compute_quota_class_v2.quota_class_1", "class_name", "default"),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_quota_class_v2.quota_class_1", "cores", "40"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_compute_quota_class_v2.quota_class_1", "instances"),
+				),
+			},
+			{
+				Config: testAccComputeQuotaClassV2Basic(20),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_compute_quota_class_v2.quota_class_1", "cores", "20"),
+				),
+			},
+		},
+	})
+}
+
+func testAccComputeQuotaClassV2Basic(cores int) string {
+	return fmt.Sprintf(`
+resource "openstack_compute_quota_class_v2" "quota_class_1" {
+  cores = %d
+}
+`, cores)
+}
@@ -0,0 +1,96 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/containerinfra/v1/clusters"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceContainerInfraClusterCertRotateV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceContainerInfraClusterCertRotateV1Create,
+		Read:   resourceContainerInfraClusterCertRotateV1Read,
+		Delete: schema.RemoveFromState,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceContainerInfraClusterCertRotateV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	containerInfraClient, err := config.ContainerInfraV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack container infra client: %s", err)
+	}
+
+	clusterID := d.Get("cluster_id").(string)
+
+	log.Printf("[DEBUG] Rotating the CA certificate of openstack_containerinfra_cluster_v1 %s", clusterID)
+
+	if err := containerInfraClusterV1RotateCA(containerInfraClient, clusterID); err != nil {
+		return fmt.Errorf("Error rotating the CA certificate of openstack_containerinfra_cluster_v1 %s: %s", clusterID, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"UPDATE_IN_PROGRESS"},
+		Target:       []string{"CREATE_COMPLETE", "UPDATE_COMPLETE"},
+		Refresh:      containerInfraClusterV1StateRefreshFunc(containerInfraClient, clusterID),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        1 * time.Minute,
+		PollInterval: 20 * time.Second,
+	}
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf(
+			"Error waiting for openstack_containerinfra_cluster_v1 %s to rotate its CA certificate: %s", clusterID, err)
+	}
+
+	d.SetId(resource.PrefixedUniqueId(fmt.Sprintf("%s-", clusterID)))
+
+	return resourceContainerInfraClusterCertRotateV1Read(d, meta)
+}
+
+func resourceContainerInfraClusterCertRotateV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	containerInfraClient, err := config.ContainerInfraV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack container infra client: %s", err)
+	}
+
+	clusterID := d.Get("cluster_id").(string)
+	_, err = clusters.Get(containerInfraClient, clusterID).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "Error retrieving openstack_containerinfra_cluster_v1")
+	}
+
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
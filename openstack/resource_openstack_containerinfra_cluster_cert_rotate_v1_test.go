@@ -0,0 +1,105 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/containerinfra/v1/certificates"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccContainerInfraV1ClusterCertRotate_basic(t *testing.T) {
+	var ca string
+
+	clusterName := acctest.RandomWithPrefix("tf-acc-cluster")
+	imageName := acctest.RandomWithPrefix("tf-acc-image")
+	keypairName := acctest.RandomWithPrefix("tf-acc-keypair")
+	clusterTemplateName := acctest.RandomWithPrefix("tf-acc-clustertemplate")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckContainerInfra(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContainerInfraV1ClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerInfraV1ClusterBasic(imageName, keypairName, clusterTemplateName, clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerInfraV1ClusterCA("openstack_containerinfra_cluster_v1.cluster_1", &ca),
+				),
+			},
+			{
+				Config: testAccContainerInfraV1ClusterCertRotateBasic(imageName, keypairName, clusterTemplateName, clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"openstack_containerinfra_cluster_cert_rotate_v1.rotate_1", "cluster_id",
+						"openstack_containerinfra_cluster_v1.cluster_1", "id"),
+					testAccCheckContainerInfraV1ClusterCARotated("openstack_containerinfra_cluster_v1.cluster_1", &ca),
+				),
+			},
+		},
+	})
+}
+
+func testAccContainerInfraV1ClusterGetCA(s *terraform.State, n string) (string, error) {
+	rs, ok := s.RootModule().Resources[n]
+	if !ok {
+		return "", fmt.Errorf("Not found: %s", n)
+	}
+
+	config := testAccProvider.Meta().(*Config)
+	containerInfraClient, err := config.ContainerInfraV1Client(osRegionName)
+	if err != nil {
+		return "", fmt.Errorf("Error creating OpenStack container infra client: %s", err)
+	}
+
+	ca, err := certificates.Get(containerInfraClient, rs.Primary.ID).Extract()
+	if err != nil {
+		return "", fmt.Errorf("Error retrieving the CA of cluster %s: %s", rs.Primary.ID, err)
+	}
+
+	return ca.PEM, nil
+}
+
+func testAccCheckContainerInfraV1ClusterCA(n string, ca *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		pem, err := testAccContainerInfraV1ClusterGetCA(s, n)
+		if err != nil {
+			return err
+		}
+
+		*ca = pem
+
+		return nil
+	}
+}
+
+func testAccCheckContainerInfraV1ClusterCARotated(n string, ca *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		pem, err := testAccContainerInfraV1ClusterGetCA(s, n)
+		if err != nil {
+			return err
+		}
+
+		if pem == *ca {
+			return fmt.Errorf("The CA of cluster %s wasn't rotated", n)
+		}
+
+		return nil
+	}
+}
+
+func testAccContainerInfraV1ClusterCertRotateBasic(imageName, keypairName, clusterTemplateName, clusterName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "openstack_containerinfra_cluster_cert_rotate_v1" "rotate_1" {
+  cluster_id = "${openstack_containerinfra_cluster_v1.cluster_1.id}"
+}
+`, testAccContainerInfraV1ClusterBasic(imageName, keypairName, clusterTemplateName, clusterName))
+}
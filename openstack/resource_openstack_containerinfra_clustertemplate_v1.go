@@ -159,6 +159,12 @@ func resourceContainerInfraClusterTemplateV1() *schema.Resource {
 				DefaultFunc: schema.EnvDefaultFunc("OS_MAGNUM_IMAGE", nil),
 			},
 
+			"hidden": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: false,
+			},
+
 			"insecure_registry": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -215,6 +221,14 @@ func resourceContainerInfraClusterTemplateV1() *schema.Resource {
 				Computed: true,
 			},
 
+			"tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: false,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
 			"tls_disabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -288,9 +302,19 @@ func resourceContainerInfraClusterTemplateV1Create(d *schema.ResourceData, meta
 		createOpts.DockerVolumeSize = &dockerVolumeSize
 	}
 
-	log.Printf("[DEBUG] openstack_containerinfra_clustertemplate_v1 create options: %#v", createOpts)
+	createOptsExt := ContainerInfraClusterTemplateCreateOptsExt{
+		CreateOptsBuilder: createOpts,
+		Tags:              expandContainerInfraV1Tags(d.Get("tags").(*schema.Set)),
+	}
 
-	s, err := clustertemplates.Create(containerInfraClient, createOpts).Extract()
+	// Only send hidden when set, it's not supported by older Magnum versions.
+	if hidden := d.Get("hidden").(bool); hidden {
+		createOptsExt.Hidden = &hidden
+	}
+
+	log.Printf("[DEBUG] openstack_containerinfra_clustertemplate_v1 create options: %#v", createOptsExt)
+
+	s, err := clustertemplates.Create(containerInfraClient, createOptsExt).Extract()
 	if err != nil {
 		return fmt.Errorf("Error creating openstack_containerinfra_clustertemplate_v1: %s", err)
 	}
@@ -308,11 +332,17 @@ func resourceContainerInfraClusterTemplateV1Read(d *schema.ResourceData, meta in
 		return fmt.Errorf("Error creating OpenStack container infra client: %s", err)
 	}
 
-	s, err := clustertemplates.Get(containerInfraClient, d.Id()).Extract()
+	r := clustertemplates.Get(containerInfraClient, d.Id())
+	s, err := r.Extract()
 	if err != nil {
 		return CheckDeleted(d, err, "Error retrieving openstack_containerinfra_clustertemplate_v1")
 	}
 
+	var ext ContainerInfraClusterTemplateExt
+	if err := r.ExtractInto(&ext); err != nil {
+		return fmt.Errorf("Unable to retrieve openstack_containerinfra_clustertemplate_v1 hidden and tags: %s", err)
+	}
+
 	log.Printf("[DEBUG] Retrieved openstack_containerinfra_clustertemplate_v1 %s: %#v", d.Id(), s)
 
 	if err := d.Set("labels", s.Labels); err != nil {
@@ -334,6 +364,7 @@ func resourceContainerInfraClusterTemplateV1Read(d *schema.ResourceData, meta in
 	d.Set("http_proxy", s.HTTPProxy)
 	d.Set("https_proxy", s.HTTPSProxy)
 	d.Set("image_id", s.ImageID)
+	d.Set("hidden", ext.Hidden)
 	d.Set("insecure_registry", s.InsecureRegistry)
 	d.Set("keypair_id", s.KeyPairID)
 	d.Set("master_lb_enabled", s.MasterLBEnabled)
@@ -342,6 +373,7 @@ func resourceContainerInfraClusterTemplateV1Read(d *schema.ResourceData, meta in
 	d.Set("public", s.Public)
 	d.Set("registry_enabled", s.RegistryEnabled)
 	d.Set("server_type", s.ServerType)
+	d.Set("tags", flattenContainerInfraV1Tags(ext.Tags))
 	d.Set("tls_disabled", s.TLSDisabled)
 	d.Set("volume_driver", s.VolumeDriver)
 	d.Set("region", GetRegion(d, config))
@@ -454,6 +486,12 @@ func resourceContainerInfraClusterTemplateV1Update(d *schema.ResourceData, meta
 		updateOpts = containerInfraClusterTemplateV1AppendUpdateOpts(updateOpts, "image_id", v)
 	}
 
+	if d.HasChange("hidden") {
+		v := d.Get("hidden").(bool)
+		hidden := strconv.FormatBool(v)
+		updateOpts = containerInfraClusterTemplateV1AppendUpdateOpts(updateOpts, "hidden", hidden)
+	}
+
 	if d.HasChange("insecure_registry") {
 		v := d.Get("insecure_registry").(string)
 		updateOpts = containerInfraClusterTemplateV1AppendUpdateOpts(updateOpts, "insecure_registry", v)
@@ -508,6 +546,11 @@ func resourceContainerInfraClusterTemplateV1Update(d *schema.ResourceData, meta
 		updateOpts = containerInfraClusterTemplateV1AppendUpdateOpts(updateOpts, "server_type", v)
 	}
 
+	if d.HasChange("tags") {
+		v := expandContainerInfraV1Tags(d.Get("tags").(*schema.Set))
+		updateOpts = containerInfraClusterTemplateV1AppendUpdateOpts(updateOpts, "tags", v)
+	}
+
 	if d.HasChange("tls_disabled") {
 		v := d.Get("tls_disabled").(bool)
 		tlsDisabled := strconv.FormatBool(v)
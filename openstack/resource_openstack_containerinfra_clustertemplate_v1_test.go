@@ -74,6 +74,44 @@ func TestAccContainerInfraV1ClusterTemplate_labels(t *testing.T) {
 	})
 }
 
+func TestAccContainerInfraV1ClusterTemplate_hidden(t *testing.T) {
+	resourceName := "openstack_containerinfra_clustertemplate_v1.clustertemplate_1"
+	clusterTemplateName := acctest.RandomWithPrefix("tf-acc-clustertemplate")
+	imageName := acctest.RandomWithPrefix("tf-acc-image")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckContainerInfra(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContainerInfraV1ClusterTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerInfraV1ClusterTemplateHidden(clusterTemplateName, imageName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "hidden", "false"),
+					resource.TestCheckResourceAttr(resourceName, "tags.#", "2"),
+				),
+			},
+			{
+				Config: testAccContainerInfraV1ClusterTemplateHidden(clusterTemplateName, imageName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "hidden", "true"),
+					resource.TestCheckResourceAttr(resourceName, "tags.#", "2"),
+				),
+			},
+			{
+				Config: testAccContainerInfraV1ClusterTemplateHidden(clusterTemplateName, imageName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "hidden", "false"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckContainerInfraV1ClusterTemplateExists(n string, clustertemplate *clustertemplates.ClusterTemplate) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -207,3 +245,29 @@ resource "openstack_containerinfra_clustertemplate_v1" "clustertemplate_1" {
 }
 `, imageName, clusterTemplateName)
 }
+
+func testAccContainerInfraV1ClusterTemplateHidden(clusterTemplateName, imageName string, hidden bool) string {
+	return fmt.Sprintf(`
+resource "openstack_images_image_v2" "image_1" {
+  name             = "%s"
+  image_source_url = "https://download.cirros-cloud.net/0.4.0/cirros-0.4.0-x86_64-disk.img"
+  container_format = "bare"
+  disk_format      = "raw"
+  properties = {
+    os_distro = "fedora-atomic"
+  }
+
+  timeouts {
+    create = "10m"
+  }
+}
+
+resource "openstack_containerinfra_clustertemplate_v1" "clustertemplate_1" {
+  name   = "%s"
+  image  = "${openstack_images_image_v2.image_1.id}"
+  coe    = "kubernetes"
+  hidden = %t
+  tags   = ["foo", "bar"]
+}
+`, imageName, clusterTemplateName, hidden)
+}
@@ -239,7 +239,7 @@ func resourceDatabaseInstanceV1Create(d *schema.ResourceData, meta interface{})
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = waitForStateContext(config.StopContext, stateConf)
 	if err != nil {
 		return fmt.Errorf("Error waiting for openstack_db_instance_v1 %s to become ready: %s", instance.ID, err)
 	}
@@ -331,7 +331,7 @@ func resourceDatabaseInstanceV1Delete(d *schema.ResourceData, meta interface{})
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = waitForStateContext(config.StopContext, stateConf)
 	if err != nil {
 		return fmt.Errorf("Error waiting for openstack_db_instance_v1 %s to delete: %s", d.Id(), err)
 	}
@@ -0,0 +1,213 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceDNSQuotaV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDNSQuotaV2Create,
+		Read:   resourceDNSQuotaV2Read,
+		Update: resourceDNSQuotaV2Update,
+		Delete: schema.RemoveFromState,
+		Importer: &schema.ResourceImporter{
+			State: importQuota("openstack_dns_quota_v2"),
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"api_export_size": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"recordset_records": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"zone_records": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"zone_recordsets": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"zones": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDNSQuotaV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	dnsClient, err := config.DNSV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	dnsQuotaV2ClientSetAuthHeader(dnsClient)
+
+	projectID := d.Get("project_id").(string)
+
+	// Only set the quotas which were specified, the others keep their
+	// current value.
+	var updateOpts dnsQuotaV2UpdateOpts
+
+	if v, ok := d.GetOkExists("api_export_size"); ok {
+		apiExportSize := v.(int)
+		updateOpts.APIExportSize = &apiExportSize
+	}
+
+	if v, ok := d.GetOkExists("recordset_records"); ok {
+		recordsetRecords := v.(int)
+		updateOpts.RecordsetRecords = &recordsetRecords
+	}
+
+	if v, ok := d.GetOkExists("zone_records"); ok {
+		zoneRecords := v.(int)
+		updateOpts.ZoneRecords = &zoneRecords
+	}
+
+	if v, ok := d.GetOkExists("zone_recordsets"); ok {
+		zoneRecordsets := v.(int)
+		updateOpts.ZoneRecordsets = &zoneRecordsets
+	}
+
+	if v, ok := d.GetOkExists("zones"); ok {
+		zones := v.(int)
+		updateOpts.Zones = &zones
+	}
+
+	q, err := dnsQuotaV2Update(dnsClient, projectID, updateOpts)
+	if err != nil {
+		return fmt.Errorf("Error creating openstack_dns_quota_v2: %s", err)
+	}
+
+	id := fmt.Sprintf("%s/%s", projectID, region)
+	d.SetId(id)
+
+	log.Printf("[DEBUG] Created openstack_dns_quota_v2 %#v", q)
+
+	return resourceDNSQuotaV2Read(d, meta)
+}
+
+func resourceDNSQuotaV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	dnsClient, err := config.DNSV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	dnsQuotaV2ClientSetAuthHeader(dnsClient)
+
+	// Parse projectID from resource id that is <project_id>/<region>
+	projectID := strings.Split(d.Id(), "/")[0]
+
+	q, err := dnsQuotaV2Get(dnsClient, projectID)
+	if err != nil {
+		return CheckDeleted(d, err, "Error retrieving openstack_dns_quota_v2")
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_dns_quota_v2 %s: %#v", d.Id(), q)
+
+	d.Set("project_id", projectID)
+	d.Set("region", region)
+	d.Set("api_export_size", q.APIExportSize)
+	d.Set("recordset_records", q.RecordsetRecords)
+	d.Set("zone_records", q.ZoneRecords)
+	d.Set("zone_recordsets", q.ZoneRecordsets)
+	d.Set("zones", q.Zones)
+	return nil
+}
+
+func resourceDNSQuotaV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dnsClient, err := config.DNSV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	dnsQuotaV2ClientSetAuthHeader(dnsClient)
+
+	var (
+		hasChange  bool
+		updateOpts dnsQuotaV2UpdateOpts
+	)
+
+	if d.HasChange("api_export_size") {
+		hasChange = true
+		apiExportSize := d.Get("api_export_size").(int)
+		updateOpts.APIExportSize = &apiExportSize
+	}
+
+	if d.HasChange("recordset_records") {
+		hasChange = true
+		recordsetRecords := d.Get("recordset_records").(int)
+		updateOpts.RecordsetRecords = &recordsetRecords
+	}
+
+	if d.HasChange("zone_records") {
+		hasChange = true
+		zoneRecords := d.Get("zone_records").(int)
+		updateOpts.ZoneRecords = &zoneRecords
+	}
+
+	if d.HasChange("zone_recordsets") {
+		hasChange = true
+		zoneRecordsets := d.Get("zone_recordsets").(int)
+		updateOpts.ZoneRecordsets = &zoneRecordsets
+	}
+
+	if d.HasChange("zones") {
+		hasChange = true
+		zones := d.Get("zones").(int)
+		updateOpts.Zones = &zones
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] openstack_dns_quota_v2 %s update options: %#v", d.Id(), updateOpts)
+		projectID := d.Get("project_id").(string)
+		_, err := dnsQuotaV2Update(dnsClient, projectID, updateOpts)
+		if err != nil {
+			return fmt.Errorf("Error updating openstack_dns_quota_v2: %s", err)
+		}
+	}
+
+	return resourceDNSQuotaV2Read(d, meta)
+}
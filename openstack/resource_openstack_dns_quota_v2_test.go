@@ -0,0 +1,76 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/projects"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccDNSV2Quota_basic(t *testing.T) {
+	var project projects.Project
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckDNS(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIdentityV3ProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSV2QuotaBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3ProjectExists("openstack_identity_project_v3.project_1", &project),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_quota_v2.quota_1", "zones", "5"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_dns_quota_v2.quota_1", "zone_recordsets"),
+				),
+			},
+			{
+				Config: testAccDNSV2QuotaUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3ProjectExists("openstack_identity_project_v3.project_1", &project),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_quota_v2.quota_1", "zones", "10"),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_quota_v2.quota_1", "zone_recordsets", "100"),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_quota_v2.quota_1", "zone_records", "200"),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_quota_v2.quota_1", "recordset_records", "10"),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_quota_v2.quota_1", "api_export_size", "500"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDNSV2QuotaBasic = `
+resource "openstack_identity_project_v3" "project_1" {
+  name = "project_1"
+}
+
+resource "openstack_dns_quota_v2" "quota_1" {
+  project_id = "${openstack_identity_project_v3.project_1.id}"
+  zones      = 5
+}
+`
+
+const testAccDNSV2QuotaUpdate = `
+resource "openstack_identity_project_v3" "project_1" {
+  name = "project_1"
+}
+
+resource "openstack_dns_quota_v2" "quota_1" {
+  project_id        = "${openstack_identity_project_v3.project_1.id}"
+  zones             = 10
+  zone_recordsets   = 100
+  zone_records      = 200
+  recordset_records = 10
+  api_export_size   = 500
+}
+`
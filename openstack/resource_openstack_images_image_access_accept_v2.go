@@ -6,8 +6,10 @@ import (
 	"strings"
 	"time"
 
+	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/members"
 
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 )
@@ -22,6 +24,10 @@ func resourceImagesImageAccessAcceptV2() *schema.Resource {
 			State: resourceImagesImageAccessAcceptV2Import,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:     schema.TypeString,
@@ -51,6 +57,12 @@ func resourceImagesImageAccessAcceptV2() *schema.Resource {
 				}, false),
 			},
 
+			"fail_on_revoked": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			// Computed-only
 			"created_at": {
 				Type:     schema.TypeString,
@@ -81,18 +93,26 @@ func resourceImagesImageAccessAcceptV2Create(d *schema.ResourceData, meta interf
 	memberID := d.Get("member_id").(string)
 	status := d.Get("status").(string)
 
-	if memberID == "" {
-		memberID, err = resourceImagesImageAccessV2DetectMemberID(imageClient, imageID)
-		if err != nil {
-			return err
-		}
-	}
-
 	// accept status on the consumer side
 	opts := members.UpdateOpts{
 		Status: status,
 	}
-	_, err = members.Update(imageClient, imageID, memberID, opts).Extract()
+	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		if memberID == "" {
+			id, err := resourceImagesImageAccessV2DetectMemberID(imageClient, imageID)
+			if err != nil {
+				return imagesImageAccessAcceptV2CheckRetryable(err)
+			}
+			memberID = id
+		}
+
+		_, err := members.Update(imageClient, imageID, memberID, opts).Extract()
+		if err != nil {
+			return imagesImageAccessAcceptV2CheckRetryable(err)
+		}
+
+		return nil
+	})
 	if err != nil {
 		return fmt.Errorf("Error setting a member status to the %q image share for the %q member: %s", imageID, memberID, err)
 	}
@@ -117,7 +137,7 @@ func resourceImagesImageAccessAcceptV2Read(d *schema.ResourceData, meta interfac
 
 	member, err := members.Get(imageClient, imageID, memberID).Extract()
 	if err != nil {
-		return CheckDeleted(d, err, "Error retrieving the openstack_images_image_access_accept_v2")
+		return imagesImageAccessAcceptV2CheckDeleted(d, err)
 	}
 
 	log.Printf("[DEBUG] Retrieved Image member %s: %#v", d.Id(), member)
@@ -146,14 +166,17 @@ func resourceImagesImageAccessAcceptV2Update(d *schema.ResourceData, meta interf
 		return err
 	}
 
-	status := d.Get("status").(string)
-
-	opts := members.UpdateOpts{
-		Status: status,
-	}
-	_, err = members.Update(imageClient, imageID, memberID, opts).Extract()
-	if err != nil {
-		return fmt.Errorf("Error updateing the %q image with the %q member: %s", imageID, memberID, err)
+	if d.HasChange("status") {
+		opts := members.UpdateOpts{
+			Status: d.Get("status").(string),
+		}
+		_, err = members.Update(imageClient, imageID, memberID, opts).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok && d.Get("fail_on_revoked").(bool) {
+				return fmt.Errorf("The openstack_images_image_access_accept_v2 %s share was revoked by the image owner", d.Id())
+			}
+			return fmt.Errorf("Error updateing the %q image with the %q member: %s", imageID, memberID, err)
+		}
 	}
 
 	return resourceImagesImageAccessAcceptV2Read(d, meta)
@@ -205,6 +228,7 @@ func resourceImagesImageAccessAcceptV2Import(d *schema.ResourceData, meta interf
 
 	id := fmt.Sprintf("%s/%s", imageID, memberID)
 	d.SetId(id)
+	d.Set("fail_on_revoked", false)
 
 	return []*schema.ResourceData{d}, nil
 }
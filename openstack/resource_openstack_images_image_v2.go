@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"log"
 	"os"
-	"strings"
 	"time"
 
 	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/imagedata"
@@ -56,6 +55,16 @@ func resourceImagesImageV2() *schema.Resource {
 				ValidateFunc: validation.StringInSlice([]string{
 					"ami", "ari", "aki", "vhd", "vmdk", "raw", "qcow2", "vdi", "iso",
 				}, false),
+				DiffSuppressFunc: imagesImageV2SuppressConvertedDiskFormat,
+			},
+
+			"convert_to_format": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"raw", "qcow2", "vmdk",
+				}, false),
 			},
 
 			"file": {
@@ -129,7 +138,13 @@ func resourceImagesImageV2() *schema.Resource {
 			"protected": {
 				Type:     schema.TypeBool,
 				Optional: true,
-				ForceNew: true,
+				ForceNew: false,
+				Default:  false,
+			},
+
+			"force_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
 				Default:  false,
 			},
 
@@ -178,6 +193,11 @@ func resourceImagesImageV2() *schema.Resource {
 			},
 
 			// Computed-only
+			"verified": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
 			"checksum": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -193,6 +213,23 @@ func resourceImagesImageV2() *schema.Resource {
 				Computed: true,
 			},
 
+			"locations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"metadata": {
+							Type:     schema.TypeMap,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"owner": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -229,6 +266,8 @@ func resourceImagesImageV2() *schema.Resource {
 
 func resourceImagesImageV2Create(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
+	defer config.imageListCache.Clear()
+
 	imageClient, err := config.ImageV2Client(GetRegion(d, config))
 	if err != nil {
 		return fmt.Errorf("Error creating OpenStack image client: %s", err)
@@ -262,6 +301,10 @@ func resourceImagesImageV2Create(d *schema.ResourceData, meta interface{}) error
 		createOpts.Tags = resourceImagesImageV2BuildTags(tags)
 	}
 
+	if err := imagesImageV2CheckConvertToFormat(imageClient, d); err != nil {
+		return err
+	}
+
 	d.Partial(true)
 
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
@@ -273,11 +316,11 @@ func resourceImagesImageV2Create(d *schema.ResourceData, meta interface{}) error
 	d.SetId(newImg.ID)
 
 	var fileChecksum string
+	var imgFilePath string
 	useWebDownload := d.Get("web_download").(bool)
 	if !useWebDownload {
 		// variable declaration
 		var err error
-		var imgFilePath string
 		var fileSize int64
 		var imgFile *os.File
 
@@ -338,11 +381,19 @@ func resourceImagesImageV2Create(d *schema.ResourceData, meta interface{}) error
 		return CheckDeleted(d, err, "image")
 	}
 
+	if convertTo := d.Get("convert_to_format").(string); convertTo != "" && img.DiskFormat != convertTo {
+		return fmt.Errorf("Error image %s was imported as %q instead of %q: "+
+			"check that the image_conversion import plugin is enabled with output_format %s", d.Id(), img.DiskFormat, convertTo, convertTo)
+	}
+
+	var verified bool
 	if v, ok := d.GetOkExists("verify_checksum"); !useWebDownload && (!ok || (ok && v.(bool))) {
-		if img.Checksum != fileChecksum {
-			return fmt.Errorf("Error wrong checksum: got %q, expected %q", img.Checksum, fileChecksum)
+		if err := imagesImageV2VerifyChecksum(img, imgFilePath, fileChecksum); err != nil {
+			return err
 		}
+		verified = true
 	}
+	d.Set("verified", verified)
 
 	d.Partial(false)
 
@@ -381,6 +432,9 @@ func resourceImagesImageV2Read(d *schema.ResourceData, meta interface{}) error {
 	d.Set("name", img.Name)
 	d.Set("protected", img.Protected)
 	d.Set("hidden", img.Hidden)
+	if err := d.Set("locations", flattenImagesImageV2Locations(img.Properties["locations"])); err != nil {
+		log.Printf("[DEBUG] Unable to set locations for image %s: %s", img.ID, err)
+	}
 	d.Set("size_bytes", img.SizeBytes)
 	d.Set("tags", img.Tags)
 	d.Set("visibility", img.Visibility)
@@ -399,6 +453,8 @@ func resourceImagesImageV2Read(d *schema.ResourceData, meta interface{}) error {
 
 func resourceImagesImageV2Update(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
+	defer config.imageListCache.Clear()
+
 	imageClient, err := config.ImageV2Client(GetRegion(d, config))
 	if err != nil {
 		return fmt.Errorf("Error creating OpenStack image client: %s", err)
@@ -407,11 +463,24 @@ func resourceImagesImageV2Update(d *schema.ResourceData, meta interface{}) error
 	updateOpts := make(images.UpdateOpts, 0)
 
 	if d.HasChange("visibility") {
-		visibility := resourceImagesImageV2VisibilityFromString(d.Get("visibility").(string))
+		o, n := d.GetChange("visibility")
+		visibility := resourceImagesImageV2VisibilityFromString(n.(string))
+
+		// Glance keeps the members of an image which is no longer shared,
+		// but they lose access to it until it's shared again.
+		if o.(string) == string(images.ImageVisibilityShared) {
+			imagesImageV2WarnInactiveMembers(imageClient, d.Id(), visibility)
+		}
+
 		v := images.UpdateVisibility{Visibility: visibility}
 		updateOpts = append(updateOpts, v)
 	}
 
+	if d.HasChange("protected") {
+		v := imagesImageV2ReplaceProtected{NewProtected: d.Get("protected").(bool)}
+		updateOpts = append(updateOpts, v)
+	}
+
 	if d.HasChange("hidden") {
 		hidden := d.Get("hidden").(bool)
 		v := images.ReplaceImageHidden{NewHidden: hidden}
@@ -436,73 +505,7 @@ func resourceImagesImageV2Update(d *schema.ResourceData, meta interface{}) error
 		oldProperties := resourceImagesImageV2ExpandProperties(o.(map[string]interface{}))
 		newProperties := resourceImagesImageV2ExpandProperties(n.(map[string]interface{}))
 
-		// Check for new and changed properties
-		for newKey, newValue := range newProperties {
-			var changed bool
-
-			oldValue, found := oldProperties[newKey]
-			if found && (newValue != oldValue) {
-				changed = true
-			}
-
-			// os_ keys are provided by the OpenStack Image service.
-			// These are read-only properties that cannot be modified.
-			// Ignore them here and let CustomizeDiff handle them.
-			if strings.HasPrefix(newKey, "os_") {
-				found = true
-				changed = false
-			}
-
-			// stores is provided by the OpenStack Image service.
-			// This is a read-only property that cannot be modified.
-			// Ignore it here and let CustomizeDiff handle it.
-			if newKey == "stores" {
-				found = true
-				changed = false
-			}
-
-			// direct_url is provided by some storage drivers.
-			// This is a read-only property that cannot be modified.
-			// Ignore it here and let CustomizeDiff handle it.
-			if newKey == "direct_url" {
-				found = true
-				changed = false
-			}
-
-			if !found {
-				v := images.UpdateImageProperty{
-					Op:    images.AddOp,
-					Name:  newKey,
-					Value: newValue,
-				}
-
-				updateOpts = append(updateOpts, v)
-			}
-
-			if found && changed {
-				v := images.UpdateImageProperty{
-					Op:    images.ReplaceOp,
-					Name:  newKey,
-					Value: newValue,
-				}
-
-				updateOpts = append(updateOpts, v)
-			}
-		}
-
-		// Check for removed properties
-		for oldKey := range oldProperties {
-			_, found := newProperties[oldKey]
-
-			if !found {
-				v := images.UpdateImageProperty{
-					Op:   images.RemoveOp,
-					Name: oldKey,
-				}
-
-				updateOpts = append(updateOpts, v)
-			}
-		}
+		updateOpts = append(updateOpts, expandImagesImageV2PropertiesUpdateOpts(oldProperties, newProperties)...)
 	}
 
 	log.Printf("[DEBUG] Update Options: %#v", updateOpts)
@@ -517,11 +520,30 @@ func resourceImagesImageV2Update(d *schema.ResourceData, meta interface{}) error
 
 func resourceImagesImageV2Delete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
+	defer config.imageListCache.Clear()
+
 	imageClient, err := config.ImageV2Client(GetRegion(d, config))
 	if err != nil {
 		return fmt.Errorf("Error creating OpenStack image client: %s", err)
 	}
 
+	if d.Get("force_destroy").(bool) {
+		img, err := images.Get(imageClient, d.Id()).Extract()
+		if err != nil {
+			return CheckDeleted(d, err, "Error retrieving image")
+		}
+
+		if img.Protected {
+			log.Printf("[DEBUG] Unprotecting Image %s before deleting it", d.Id())
+			updateOpts := images.UpdateOpts{
+				imagesImageV2ReplaceProtected{NewProtected: false},
+			}
+			if _, err := images.Update(imageClient, d.Id(), updateOpts).Extract(); err != nil {
+				return fmt.Errorf("Error unprotecting Image %s: %s", d.Id(), err)
+			}
+		}
+	}
+
 	log.Printf("[DEBUG] Deleting Image %s", d.Id())
 	if err := images.Delete(imageClient, d.Id()).Err; err != nil {
 		return fmt.Errorf("Error deleting Image: %s", err)
@@ -2,6 +2,8 @@ package openstack
 
 import (
 	"fmt"
+	"io/ioutil"
+	"os"
 	"testing"
 
 	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/images"
@@ -176,6 +178,84 @@ func TestAccImagesImageV2_visibility(t *testing.T) {
 	})
 }
 
+func TestAccImagesImageV2_visibilityCommunity(t *testing.T) {
+	var image1 images.Image
+	var image2 images.Image
+	var image3 images.Image
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckImagesImageV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImagesImageV2VisibilityCommunity("private"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagesImageV2Exists("openstack_images_image_v2.image_1", &image1),
+					resource.TestCheckResourceAttr(
+						"openstack_images_image_v2.image_1", "visibility", "private"),
+				),
+			},
+			{
+				Config: testAccImagesImageV2VisibilityCommunity("community"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagesImageV2Exists("openstack_images_image_v2.image_1", &image2),
+					testAccCheckImagesImageV2SameID(&image1, &image2),
+					resource.TestCheckResourceAttr(
+						"openstack_images_image_v2.image_1", "visibility", "community"),
+				),
+			},
+			{
+				Config: testAccImagesImageV2VisibilityCommunity("shared"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagesImageV2Exists("openstack_images_image_v2.image_1", &image3),
+					testAccCheckImagesImageV2SameID(&image1, &image3),
+					resource.TestCheckResourceAttr(
+						"openstack_images_image_v2.image_1", "visibility", "shared"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccImagesImageV2_protectedForceDestroy(t *testing.T) {
+	var image1 images.Image
+	var image2 images.Image
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckImagesImageV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImagesImageV2Protected(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagesImageV2Exists("openstack_images_image_v2.image_1", &image1),
+					resource.TestCheckResourceAttr(
+						"openstack_images_image_v2.image_1", "protected", "false"),
+				),
+			},
+			{
+				Config: testAccImagesImageV2Protected(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagesImageV2Exists("openstack_images_image_v2.image_1", &image2),
+					testAccCheckImagesImageV2SameID(&image1, &image2),
+					resource.TestCheckResourceAttr(
+						"openstack_images_image_v2.image_1", "protected", "true"),
+					resource.TestCheckResourceAttr(
+						"openstack_images_image_v2.image_1", "force_destroy", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccImagesImageV2_properties(t *testing.T) {
 	var image1 images.Image
 	var image2 images.Image
@@ -215,8 +295,11 @@ func TestAccImagesImageV2_properties(t *testing.T) {
 				Config: testAccImagesImageV2Properties2,
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckImagesImageV2Exists("openstack_images_image_v2.image_1", &image3),
+					testAccCheckImagesImageV2NoProperty(&image3, "bar"),
 					resource.TestCheckResourceAttr(
 						"openstack_images_image_v2.image_1", "properties.foo", "bar"),
+					resource.TestCheckNoResourceAttr(
+						"openstack_images_image_v2.image_1", "properties.bar"),
 					resource.TestCheckResourceAttrSet(
 						"openstack_images_image_v2.image_1", "properties.os_hash_value"),
 				),
@@ -277,6 +360,79 @@ func TestAccImagesImageV2_webdownload(t *testing.T) {
 	})
 }
 
+func TestAccImagesImageV2_webdownloadConvert(t *testing.T) {
+	var image images.Image
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckGlanceImport(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckImagesImageV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImagesImageV2WebdownloadConvert,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagesImageV2Exists("openstack_images_image_v2.image_1", &image),
+					resource.TestCheckResourceAttr(
+						"openstack_images_image_v2.image_1", "convert_to_format", "raw"),
+					resource.TestCheckResourceAttr(
+						"openstack_images_image_v2.image_1", "disk_format", "raw"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccImagesImageV2_localFileVerified(t *testing.T) {
+	var image images.Image
+
+	tmpFile, err := ioutil.TempFile("", "tf_test_images_image")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(tmpFile.Name())
+	if _, err := tmpFile.Write([]byte("terraform acceptance test image")); err != nil {
+		t.Fatal(err)
+	}
+	if err := tmpFile.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckImagesImageV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImagesImageV2LocalFile(tmpFile.Name()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagesImageV2Exists("openstack_images_image_v2.image_1", &image),
+					resource.TestCheckResourceAttr(
+						"openstack_images_image_v2.image_1", "verified", "true"),
+					resource.TestCheckResourceAttr(
+						"openstack_images_image_v2.image_1", "checksum", "78e5c926d54e947e930df8ad489e42af"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckImagesImageV2SameID(image1, image2 *images.Image) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if image1.ID != image2.ID {
+			return fmt.Errorf("Image was recreated: %s != %s", image1.ID, image2.ID)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckImagesImageV2Destroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	imageClient, err := config.ImageV2Client(osRegionName)
@@ -330,6 +486,16 @@ func testAccCheckImagesImageV2Exists(n string, image *images.Image) resource.Tes
 	}
 }
 
+func testAccCheckImagesImageV2NoProperty(image *images.Image, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if v, ok := image.Properties[key]; ok {
+			return fmt.Errorf("Image %s still has property %s: %v", image.ID, key, v)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckImagesImageV2HasTag(n, tag string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -499,6 +665,31 @@ const testAccImagesImageV2Visibility2 = `
       visibility = "public"
   }`
 
+func testAccImagesImageV2VisibilityCommunity(visibility string) string {
+	return fmt.Sprintf(`
+resource "openstack_images_image_v2" "image_1" {
+  name             = "Rancher TerraformAccTest"
+  image_source_url = "https://releases.rancher.com/os/latest/rancheros-openstack.img"
+  container_format = "bare"
+  disk_format      = "qcow2"
+  visibility       = "%s"
+}
+`, visibility)
+}
+
+func testAccImagesImageV2Protected(protected bool) string {
+	return fmt.Sprintf(`
+resource "openstack_images_image_v2" "image_1" {
+  name             = "Rancher TerraformAccTest"
+  image_source_url = "https://releases.rancher.com/os/latest/rancheros-openstack.img"
+  container_format = "bare"
+  disk_format      = "qcow2"
+  protected        = %t
+  force_destroy    = true
+}
+`, protected)
+}
+
 const testAccImagesImageV2Properties1 = `
   resource "openstack_images_image_v2" "image_1" {
       name   = "Rancher TerraformAccTest"
@@ -561,3 +752,28 @@ const testAccImagesImageV2Webdownload = `
         create = "10m"
       }
   }`
+
+const testAccImagesImageV2WebdownloadConvert = `
+  resource "openstack_images_image_v2" "image_1" {
+      name   = "Rancher TerraformAccTest"
+      image_source_url = "https://releases.rancher.com/os/latest/rancheros-openstack.img"
+      container_format = "bare"
+      disk_format = "qcow2"
+      convert_to_format = "raw"
+      web_download = true
+
+      timeouts {
+        create = "10m"
+      }
+  }`
+
+func testAccImagesImageV2LocalFile(path string) string {
+	return fmt.Sprintf(`
+resource "openstack_images_image_v2" "image_1" {
+  name             = "Local TerraformAccTest"
+  local_file_path  = "%s"
+  container_format = "bare"
+  disk_format      = "raw"
+}
+`, path)
+}
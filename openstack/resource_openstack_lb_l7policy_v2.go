@@ -60,7 +60,7 @@ func resourceL7PolicyV2() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 				ValidateFunc: validation.StringInSlice([]string{
-					"REDIRECT_TO_POOL", "REDIRECT_TO_URL", "REJECT",
+					"REDIRECT_TO_POOL", "REDIRECT_TO_URL", "REDIRECT_PREFIX", "REJECT",
 				}, true),
 			},
 
@@ -78,13 +78,13 @@ func resourceL7PolicyV2() *schema.Resource {
 
 			"redirect_pool_id": {
 				Type:          schema.TypeString,
-				ConflictsWith: []string{"redirect_url"},
+				ConflictsWith: []string{"redirect_url", "redirect_prefix"},
 				Optional:      true,
 			},
 
 			"redirect_url": {
 				Type:          schema.TypeString,
-				ConflictsWith: []string{"redirect_pool_id"},
+				ConflictsWith: []string{"redirect_pool_id", "redirect_prefix"},
 				Optional:      true,
 				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
 					value := v.(string)
@@ -96,6 +96,27 @@ func resourceL7PolicyV2() *schema.Resource {
 				},
 			},
 
+			"redirect_prefix": {
+				Type:          schema.TypeString,
+				ConflictsWith: []string{"redirect_pool_id", "redirect_url"},
+				Optional:      true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					value := v.(string)
+					_, err := url.ParseRequestURI(value)
+					if err != nil {
+						errors = append(errors, fmt.Errorf("URL is not valid: %s", err))
+					}
+					return
+				},
+			},
+
+			"redirect_http_code": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntInSlice([]int{301, 302, 303, 307, 308}),
+			},
+
 			"admin_state_up": {
 				Type:     schema.TypeBool,
 				Default:  true,
@@ -117,15 +138,17 @@ func resourceL7PolicyV2Create(d *schema.ResourceData, meta interface{}) error {
 	action := d.Get("action").(string)
 	redirectPoolID := d.Get("redirect_pool_id").(string)
 	redirectURL := d.Get("redirect_url").(string)
+	redirectPrefix := d.Get("redirect_prefix").(string)
+	redirectHTTPCode := d.Get("redirect_http_code").(int)
 
 	// Ensure the right combination of options have been specified.
-	err = checkL7PolicyAction(action, redirectURL, redirectPoolID)
+	err = checkL7PolicyAction(action, redirectURL, redirectPoolID, redirectPrefix, redirectHTTPCode)
 	if err != nil {
 		return fmt.Errorf("Unable to create L7 Policy: %s", err)
 	}
 
 	adminStateUp := d.Get("admin_state_up").(bool)
-	createOpts := l7policies.CreateOpts{
+	opts := l7policies.CreateOpts{
 		TenantID:       d.Get("tenant_id").(string),
 		Name:           d.Get("name").(string),
 		Description:    d.Get("description").(string),
@@ -137,7 +160,13 @@ func resourceL7PolicyV2Create(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if v, ok := d.GetOk("position"); ok {
-		createOpts.Position = int32(v.(int))
+		opts.Position = int32(v.(int))
+	}
+
+	createOpts := L7PolicyRedirectCreateOptsExt{
+		CreateOptsBuilder: opts,
+		RedirectPrefix:    redirectPrefix,
+		RedirectHTTPCode:  redirectHTTPCode,
 	}
 
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
@@ -201,7 +230,11 @@ func resourceL7PolicyV2Read(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
-	l7Policy, err := l7policies.Get(lbClient, d.Id()).Extract()
+	var l7Policy struct {
+		l7policies.L7Policy
+		L7PolicyRedirectExt
+	}
+	err = l7policies.Get(lbClient, d.Id()).ExtractIntoStructPtr(&l7Policy, "l7policy")
 	if err != nil {
 		return CheckDeleted(d, err, "L7 Policy")
 	}
@@ -215,6 +248,8 @@ func resourceL7PolicyV2Read(d *schema.ResourceData, meta interface{}) error {
 	d.Set("position", int(l7Policy.Position))
 	d.Set("redirect_url", l7Policy.RedirectURL)
 	d.Set("redirect_pool_id", l7Policy.RedirectPoolID)
+	d.Set("redirect_prefix", l7Policy.RedirectPrefix)
+	d.Set("redirect_http_code", l7Policy.RedirectHTTPCode)
 	d.Set("region", GetRegion(d, config))
 	d.Set("admin_state_up", l7Policy.AdminStateUp)
 
@@ -233,39 +268,54 @@ func resourceL7PolicyV2Update(d *schema.ResourceData, meta interface{}) error {
 	action := d.Get("action").(string)
 	redirectPoolID := d.Get("redirect_pool_id").(string)
 	redirectURL := d.Get("redirect_url").(string)
+	redirectPrefix := d.Get("redirect_prefix").(string)
 
-	var updateOpts l7policies.UpdateOpts
+	// redirect_http_code is computed, so only validate and send it when it
+	// was explicitly changed. Otherwise a stale value would block switching
+	// the action away from a redirect.
+	var redirectHTTPCode int
+	if d.HasChange("redirect_http_code") {
+		redirectHTTPCode = d.Get("redirect_http_code").(int)
+	}
+
+	var opts l7policies.UpdateOpts
+	var updateOpts L7PolicyRedirectUpdateOptsExt
 
 	if d.HasChange("action") {
-		updateOpts.Action = l7policies.Action(action)
+		opts.Action = l7policies.Action(action)
 	}
 	if d.HasChange("name") {
 		name := d.Get("name").(string)
-		updateOpts.Name = &name
+		opts.Name = &name
 	}
 	if d.HasChange("description") {
 		description := d.Get("description").(string)
-		updateOpts.Description = &description
+		opts.Description = &description
 	}
 	if d.HasChange("redirect_pool_id") {
 		redirectPoolID = d.Get("redirect_pool_id").(string)
 
-		updateOpts.RedirectPoolID = &redirectPoolID
+		opts.RedirectPoolID = &redirectPoolID
 	}
 	if d.HasChange("redirect_url") {
 		redirectURL = d.Get("redirect_url").(string)
-		updateOpts.RedirectURL = &redirectURL
+		opts.RedirectURL = &redirectURL
 	}
 	if d.HasChange("position") {
-		updateOpts.Position = int32(d.Get("position").(int))
+		opts.Position = int32(d.Get("position").(int))
 	}
 	if d.HasChange("admin_state_up") {
 		adminStateUp := d.Get("admin_state_up").(bool)
-		updateOpts.AdminStateUp = &adminStateUp
+		opts.AdminStateUp = &adminStateUp
+	}
+	if d.HasChange("redirect_prefix") {
+		updateOpts.RedirectPrefix = &redirectPrefix
 	}
+	updateOpts.RedirectHTTPCode = redirectHTTPCode
+	updateOpts.UpdateOptsBuilder = opts
 
 	// Ensure the right combination of options have been specified.
-	err = checkL7PolicyAction(action, redirectURL, redirectPoolID)
+	err = checkL7PolicyAction(action, redirectURL, redirectPoolID, redirectPrefix, redirectHTTPCode)
 	if err != nil {
 		return err
 	}
@@ -407,20 +457,33 @@ func resourceL7PolicyV2Import(d *schema.ResourceData, meta interface{}) ([]*sche
 	return []*schema.ResourceData{d}, nil
 }
 
-func checkL7PolicyAction(action, redirectURL, redirectPoolID string) error {
+func checkL7PolicyAction(action, redirectURL, redirectPoolID, redirectPrefix string, redirectHTTPCode int) error {
 	if action == "REJECT" {
-		if redirectURL != "" || redirectPoolID != "" {
+		if redirectURL != "" || redirectPoolID != "" || redirectPrefix != "" {
 			return fmt.Errorf(
-				"redirect_url and redirect_pool_id must be empty when action is set to %s", action)
+				"redirect_url, redirect_pool_id and redirect_prefix must be empty when action is set to %s", action)
 		}
 	}
 
-	if action == "REDIRECT_TO_POOL" && redirectURL != "" {
-		return fmt.Errorf("redirect_url must be empty when action is set to %s", action)
+	if action == "REDIRECT_TO_POOL" && (redirectURL != "" || redirectPrefix != "") {
+		return fmt.Errorf("redirect_url and redirect_prefix must be empty when action is set to %s", action)
+	}
+
+	if action == "REDIRECT_TO_URL" && (redirectPoolID != "" || redirectPrefix != "") {
+		return fmt.Errorf("redirect_pool_id and redirect_prefix must be empty when action is set to %s", action)
+	}
+
+	if action == "REDIRECT_PREFIX" {
+		if redirectPoolID != "" || redirectURL != "" {
+			return fmt.Errorf("redirect_pool_id and redirect_url must be empty when action is set to %s", action)
+		}
+		if redirectPrefix == "" {
+			return fmt.Errorf("redirect_prefix must be set when action is set to %s", action)
+		}
 	}
 
-	if action == "REDIRECT_TO_URL" && redirectPoolID != "" {
-		return fmt.Errorf("redirect_pool_id must be empty when action is set to %s", action)
+	if redirectHTTPCode != 0 && action != "REDIRECT_TO_URL" && action != "REDIRECT_PREFIX" {
+		return fmt.Errorf("redirect_http_code can only be set when action is set to REDIRECT_TO_URL or REDIRECT_PREFIX")
 	}
 
 	return nil
@@ -100,6 +100,47 @@ func TestAccLBV2L7Policy_basic(t *testing.T) {
 	})
 }
 
+func TestAccLBV2L7Policy_redirectHTTPCode(t *testing.T) {
+	var l7Policy l7policies.L7Policy
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckLB(t)
+			testAccPreCheckUseOctavia(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLBV2L7PolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckLbV2L7PolicyConfigRedirectHTTPCode(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2L7PolicyExists("openstack_lb_l7policy_v2.l7policy_1", &l7Policy),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_l7policy_v2.l7policy_1", "action", "REDIRECT_TO_URL"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_l7policy_v2.l7policy_1", "redirect_url", "http://www.example.com"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_l7policy_v2.l7policy_1", "redirect_http_code", "301"),
+				),
+			},
+			{
+				Config: testAccCheckLbV2L7PolicyConfigRedirectPrefix(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2L7PolicyExists("openstack_lb_l7policy_v2.l7policy_1", &l7Policy),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_l7policy_v2.l7policy_1", "action", "REDIRECT_PREFIX"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_l7policy_v2.l7policy_1", "redirect_prefix", "https://www.example.com"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_l7policy_v2.l7policy_1", "redirect_http_code", "308"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckLBV2L7PolicyDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	lbClient, err := chooseLBV2AccTestClient(config, osRegionName)
@@ -254,3 +295,33 @@ resource "openstack_lb_l7policy_v2" "l7policy_1" {
 }
 `, testAccCheckLbV2L7PolicyConfig)
 }
+
+func testAccCheckLbV2L7PolicyConfigRedirectHTTPCode() string {
+	return fmt.Sprintf(`
+%s
+
+resource "openstack_lb_l7policy_v2" "l7policy_1" {
+  name               = "test"
+  action             = "REDIRECT_TO_URL"
+  position           = 1
+  listener_id        = "${openstack_lb_listener_v2.listener_1.id}"
+  redirect_url       = "http://www.example.com"
+  redirect_http_code = 301
+}
+`, testAccCheckLbV2L7PolicyConfig)
+}
+
+func testAccCheckLbV2L7PolicyConfigRedirectPrefix() string {
+	return fmt.Sprintf(`
+%s
+
+resource "openstack_lb_l7policy_v2" "l7policy_1" {
+  name               = "test"
+  action             = "REDIRECT_PREFIX"
+  position           = 1
+  listener_id        = "${openstack_lb_listener_v2.listener_1.id}"
+  redirect_prefix    = "https://www.example.com"
+  redirect_http_code = 308
+}
+`, testAccCheckLbV2L7PolicyConfig)
+}
@@ -136,9 +136,55 @@ func resourceListenerV2() *schema.Resource {
 			},
 
 			"allowed_cidrs": {
+				Type:             schema.TypeList,
+				Optional:         true,
+				Elem:             &schema.Schema{Type: schema.TypeString},
+				DiffSuppressFunc: suppressLBV2ListenerAllowedCIDRsReorder,
+			},
+
+			"alpn_protocols": {
 				Type:     schema.TypeList,
 				Optional: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"http/1.0", "http/1.1", "h2",
+					}, false),
+				},
+			},
+
+			"tls_ciphers": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+
+			"tls_versions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"SSLv3", "TLSv1", "TLSv1.1", "TLSv1.2", "TLSv1.3",
+					}, false),
+				},
+			},
+
+			"client_authentication": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"NONE", "OPTIONAL", "MANDATORY",
+				}, false),
+			},
+
+			"client_ca_tls_container_ref": {
+				Type:     schema.TypeString,
+				Optional: true,
 			},
 		},
 	}
@@ -199,7 +245,11 @@ func resourceListenerV2Read(d *schema.ResourceData, meta interface{}) error {
 
 	// Use Octavia listener body if Octavia/LBaaS is enabled.
 	if config.UseOctavia {
-		listener, err := octavialisteners.Get(lbClient, d.Id()).Extract()
+		var listener struct {
+			octavialisteners.Listener
+			ListenerTLSExt
+		}
+		err := octavialisteners.Get(lbClient, d.Id()).ExtractIntoStructPtr(&listener, "listener")
 		if err != nil {
 			return CheckDeleted(d, err, "openstack_lb_listener_v2")
 		}
@@ -221,6 +271,11 @@ func resourceListenerV2Read(d *schema.ResourceData, meta interface{}) error {
 		d.Set("sni_container_refs", listener.SniContainerRefs)
 		d.Set("default_tls_container_ref", listener.DefaultTlsContainerRef)
 		d.Set("allowed_cidrs", listener.AllowedCIDRs)
+		d.Set("alpn_protocols", listener.ALPNProtocols)
+		d.Set("tls_ciphers", listener.TLSCiphers)
+		d.Set("tls_versions", listener.TLSVersions)
+		d.Set("client_authentication", listener.ClientAuthentication)
+		d.Set("client_ca_tls_container_ref", listener.ClientCATLSContainerRef)
 		d.Set("region", GetRegion(d, config))
 
 		// Required by import.
@@ -164,6 +164,86 @@ func TestAccLBV2ListenerConfig_octavia_insert_headers(t *testing.T) {
 	})
 }
 
+func TestAccLBV2Listener_octavia_tls(t *testing.T) {
+	var listener listeners.Listener
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckLB(t)
+			testAccPreCheckUseOctavia(t)
+			testAccPreCheckLBTLS(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLBV2ListenerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLbV2ListenerConfigOctaviaTLS(`["TLSv1.2", "TLSv1.3"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2ListenerExists("openstack_lb_listener_v2.listener_1", &listener),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_listener_v2.listener_1", "protocol", "TERMINATED_HTTPS"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_listener_v2.listener_1", "tls_versions.#", "2"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_listener_v2.listener_1", "tls_versions.0", "TLSv1.2"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_listener_v2.listener_1", "tls_versions.1", "TLSv1.3"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_listener_v2.listener_1", "alpn_protocols.#", "2"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_listener_v2.listener_1", "tls_ciphers", "ECDHE-ECDSA-AES256-GCM-SHA384:ECDHE-RSA-AES256-GCM-SHA384"),
+				),
+			},
+			{
+				Config: testAccLbV2ListenerConfigOctaviaTLS(`["TLSv1.3"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2ListenerExists("openstack_lb_listener_v2.listener_1", &listener),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_listener_v2.listener_1", "tls_versions.#", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_listener_v2.listener_1", "tls_versions.0", "TLSv1.3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLBV2Listener_octavia_clientAuthentication(t *testing.T) {
+	var listener listeners.Listener
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckLB(t)
+			testAccPreCheckUseOctavia(t)
+			testAccPreCheckLBClientCA(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLBV2ListenerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLbV2ListenerConfigOctaviaClientAuthentication(`["192.168.199.0/24", "10.0.0.0/8"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2ListenerExists("openstack_lb_listener_v2.listener_1", &listener),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_listener_v2.listener_1", "client_authentication", "MANDATORY"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_listener_v2.listener_1", "client_ca_tls_container_ref", osLbClientCAContainerRef),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_listener_v2.listener_1", "allowed_cidrs.#", "2"),
+				),
+			},
+			{
+				Config:   testAccLbV2ListenerConfigOctaviaClientAuthentication(`["10.0.0.0/8", "192.168.199.0/24"]`),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func testAccCheckLBV2ListenerDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	lbClient, err := chooseLBV2AccTestClient(config, osRegionName)
@@ -553,3 +633,91 @@ resource "openstack_lb_listener_v2" "listener_1" {
   }
 }
 `
+
+func testAccLbV2ListenerConfigOctaviaTLS(tlsVersions string) string {
+	return fmt.Sprintf(`
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+}
+
+resource "openstack_lb_loadbalancer_v2" "loadbalancer_1" {
+  name = "loadbalancer_1"
+  vip_subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+
+  timeouts {
+    create = "15m"
+    update = "15m"
+    delete = "15m"
+  }
+}
+
+resource "openstack_lb_listener_v2" "listener_1" {
+  name = "listener_1"
+  protocol = "TERMINATED_HTTPS"
+  protocol_port = 443
+  loadbalancer_id = "${openstack_lb_loadbalancer_v2.loadbalancer_1.id}"
+  default_tls_container_ref = "%s"
+  alpn_protocols = ["h2", "http/1.1"]
+  tls_ciphers = "ECDHE-ECDSA-AES256-GCM-SHA384:ECDHE-RSA-AES256-GCM-SHA384"
+  tls_versions = %s
+
+  timeouts {
+    create = "5m"
+    update = "5m"
+    delete = "5m"
+  }
+}
+`, osLbTLSContainerRef, tlsVersions)
+}
+
+func testAccLbV2ListenerConfigOctaviaClientAuthentication(allowedCIDRs string) string {
+	return fmt.Sprintf(`
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+}
+
+resource "openstack_lb_loadbalancer_v2" "loadbalancer_1" {
+  name = "loadbalancer_1"
+  vip_subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+
+  timeouts {
+    create = "15m"
+    update = "15m"
+    delete = "15m"
+  }
+}
+
+resource "openstack_lb_listener_v2" "listener_1" {
+  name = "listener_1"
+  protocol = "TERMINATED_HTTPS"
+  protocol_port = 443
+  loadbalancer_id = "${openstack_lb_loadbalancer_v2.loadbalancer_1.id}"
+  default_tls_container_ref = "%s"
+  client_authentication = "MANDATORY"
+  client_ca_tls_container_ref = "%s"
+  allowed_cidrs = %s
+
+  timeouts {
+    create = "5m"
+    update = "5m"
+    delete = "5m"
+  }
+}
+`, osLbTLSContainerRef, osLbClientCAContainerRef, allowedCIDRs)
+}
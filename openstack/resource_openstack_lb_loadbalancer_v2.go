@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 
 	octavialoadbalancers "github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/loadbalancers"
 	neutronloadbalancers "github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/loadbalancers"
@@ -114,6 +115,34 @@ func resourceLoadBalancerV2() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Set:      schema.HashString,
 			},
+
+			"failover_trigger": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"additional_vip": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+
+						"ip_address": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.SingleIP(),
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -157,14 +186,24 @@ func resourceLoadBalancerV2Create(d *schema.ResourceData, meta interface{}) erro
 			createOpts.AvailabilityZone = aZ
 		}
 
-		log.Printf("[DEBUG][Octavia] openstack_lb_loadbalancer_v2 create options: %#v", createOpts)
-		lb, err := octavialoadbalancers.Create(lbClient, createOpts).Extract()
+		// additional_vips requires octavia minor version 2.26. Only set when specified.
+		vipOpts := LoadBalancerAdditionalVIPsCreateOptsExt{
+			CreateOptsBuilder: createOpts,
+			AdditionalVIPs:    expandLBV2LoadBalancerAdditionalVIPs(d.Get("additional_vip").([]interface{})),
+		}
+
+		log.Printf("[DEBUG][Octavia] openstack_lb_loadbalancer_v2 create options: %#v", vipOpts)
+		lb, err := octavialoadbalancers.Create(lbClient, vipOpts).Extract()
 		if err != nil {
 			return fmt.Errorf("Error creating openstack_lb_loadbalancer_v2: %s", err)
 		}
 		lbID = lb.ID
 		vipPortID = lb.VipPortID
 	} else {
+		if _, ok := d.GetOk("additional_vip"); ok {
+			return fmt.Errorf("additional_vip is only supported by Octavia")
+		}
+
 		createOpts := neutronloadbalancers.CreateOpts{
 			Name:         d.Get("name").(string),
 			Description:  d.Get("description").(string),
@@ -217,11 +256,17 @@ func resourceLoadBalancerV2Read(d *schema.ResourceData, meta interface{}) error
 	var vipPortID string
 
 	if lbClient.Type == octaviaLBClientType {
-		lb, err := octavialoadbalancers.Get(lbClient, d.Id()).Extract()
+		r := octavialoadbalancers.Get(lbClient, d.Id())
+		lb, err := r.Extract()
 		if err != nil {
 			return CheckDeleted(d, err, "Unable to retrieve openstack_lb_loadbalancer_v2")
 		}
 
+		var vips LoadBalancerAdditionalVIPsExt
+		if err := r.ExtractIntoStructPtr(&vips, "loadbalancer"); err != nil {
+			return fmt.Errorf("Unable to retrieve openstack_lb_loadbalancer_v2 additional_vips: %s", err)
+		}
+
 		log.Printf("[DEBUG][Octavia] Retrieved openstack_lb_loadbalancer_v2 %s: %#v", d.Id(), lb)
 
 		d.Set("name", lb.Name)
@@ -235,6 +280,7 @@ func resourceLoadBalancerV2Read(d *schema.ResourceData, meta interface{}) error
 		d.Set("flavor_id", lb.FlavorID)
 		d.Set("loadbalancer_provider", lb.Provider)
 		d.Set("availability_zone", lb.AvailabilityZone)
+		d.Set("additional_vip", flattenLBV2LoadBalancerAdditionalVIPs(vips.AdditionalVIPs))
 		d.Set("region", GetRegion(d, config))
 		vipPortID = lb.VipPortID
 	} else {
@@ -321,6 +367,37 @@ func resourceLoadBalancerV2Update(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
+	// Trigger an amphora failover whenever a new failover_trigger is set.
+	if d.HasChange("failover_trigger") && d.Get("failover_trigger").(string) != "" {
+		if lbClient.Type != octaviaLBClientType {
+			return fmt.Errorf("failover_trigger is only supported by Octavia")
+		}
+
+		timeout := d.Timeout(schema.TimeoutUpdate)
+		err = waitForLBV2LoadBalancer(lbClient, d.Id(), "ACTIVE", getLbPendingStatuses(), timeout)
+		if err != nil {
+			return err
+		}
+
+		log.Printf("[DEBUG] Triggering failover of openstack_lb_loadbalancer_v2 %s", d.Id())
+		err = resource.Retry(timeout, func() *resource.RetryError {
+			err = octavialoadbalancers.Failover(lbClient, d.Id()).ExtractErr()
+			if err != nil {
+				return checkForRetryableError(err)
+			}
+			return nil
+		})
+
+		if err != nil {
+			return fmt.Errorf("Error triggering failover of openstack_lb_loadbalancer_v2 %s: %s", d.Id(), err)
+		}
+
+		err = waitForLBV2LoadBalancer(lbClient, d.Id(), "ACTIVE", getLbPendingStatuses(), timeout)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Security Groups get updated separately.
 	if d.HasChange("security_group_ids") {
 		networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 
+	octaviapools "github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/pools"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/pools"
 )
 
@@ -86,6 +87,23 @@ func resourceMemberV2() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+
+			"backup": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"monitor_address": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.SingleIP(),
+			},
+
+			"monitor_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 65535),
+			},
 		},
 	}
 }
@@ -98,7 +116,7 @@ func resourceMemberV2Create(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	adminStateUp := d.Get("admin_state_up").(bool)
-	createOpts := pools.CreateMemberOpts{
+	opts := pools.CreateMemberOpts{
 		Name:         d.Get("name").(string),
 		TenantID:     d.Get("tenant_id").(string),
 		Address:      d.Get("address").(string),
@@ -108,17 +126,50 @@ func resourceMemberV2Create(d *schema.ResourceData, meta interface{}) error {
 
 	// Must omit if not set
 	if v, ok := d.GetOk("subnet_id"); ok {
-		createOpts.SubnetID = v.(string)
+		opts.SubnetID = v.(string)
 	}
 
 	// Set the weight only if it's defined in the configuration.
 	// This prevents all members from being created with a default weight of 0.
 	if v, ok := d.GetOkExists("weight"); ok {
 		weight := v.(int)
-		createOpts.Weight = &weight
+		opts.Weight = &weight
 	}
 
-	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	// backup, monitor_address and monitor_port are only supported by the
+	// Octavia member options.
+	var octaviaOpts octaviapools.CreateMemberOpts
+	if config.UseOctavia {
+		octaviaOpts = octaviapools.CreateMemberOpts{
+			Name:           opts.Name,
+			ProjectID:      opts.TenantID,
+			Address:        opts.Address,
+			ProtocolPort:   opts.ProtocolPort,
+			Weight:         opts.Weight,
+			SubnetID:       opts.SubnetID,
+			AdminStateUp:   opts.AdminStateUp,
+			MonitorAddress: d.Get("monitor_address").(string),
+		}
+
+		if v, ok := d.GetOk("monitor_port"); ok {
+			monitorPort := v.(int)
+			octaviaOpts.MonitorPort = &monitorPort
+		}
+
+		// backup requires octavia minor version 2.1. Only set when specified.
+		if v, ok := d.GetOkExists("backup"); ok {
+			backup := v.(bool)
+			octaviaOpts.Backup = &backup
+		}
+
+		log.Printf("[DEBUG] Create Options: %#v", octaviaOpts)
+	} else {
+		if err := checkLBV2MemberOctaviaOnly(d); err != nil {
+			return err
+		}
+
+		log.Printf("[DEBUG] Create Options: %#v", opts)
+	}
 
 	// Get a clean copy of the parent pool.
 	poolID := d.Get("pool_id").(string)
@@ -127,6 +178,11 @@ func resourceMemberV2Create(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Unable to retrieve parent pool %s: %s", poolID, err)
 	}
 
+	err = checkLBV2MemberBackup(parentPool.LBMethod, d.Get("backup").(bool))
+	if err != nil {
+		return err
+	}
+
 	// Wait for parent pool to become active before continuing
 	timeout := d.Timeout(schema.TimeoutCreate)
 	err = waitForLBV2Pool(lbClient, parentPool, "ACTIVE", getLbPendingStatuses(), timeout)
@@ -137,7 +193,12 @@ func resourceMemberV2Create(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[DEBUG] Attempting to create member")
 	var member *pools.Member
 	err = resource.Retry(timeout, func() *resource.RetryError {
-		member, err = pools.CreateMember(lbClient, poolID, createOpts).Extract()
+		if config.UseOctavia {
+			member = &pools.Member{}
+			err = octaviapools.CreateMember(lbClient, poolID, octaviaOpts).ExtractIntoStructPtr(member, "member")
+		} else {
+			member, err = pools.CreateMember(lbClient, poolID, opts).Extract()
+		}
 		if err != nil {
 			return checkForRetryableError(err)
 		}
@@ -168,7 +229,11 @@ func resourceMemberV2Read(d *schema.ResourceData, meta interface{}) error {
 
 	poolID := d.Get("pool_id").(string)
 
-	member, err := pools.GetMember(lbClient, poolID, d.Id()).Extract()
+	var member struct {
+		pools.Member
+		MemberExt
+	}
+	err = pools.GetMember(lbClient, poolID, d.Id()).ExtractIntoStructPtr(&member, "member")
 	if err != nil {
 		return CheckDeleted(d, err, "member")
 	}
@@ -182,6 +247,9 @@ func resourceMemberV2Read(d *schema.ResourceData, meta interface{}) error {
 	d.Set("subnet_id", member.SubnetID)
 	d.Set("address", member.Address)
 	d.Set("protocol_port", member.ProtocolPort)
+	d.Set("backup", member.Backup)
+	d.Set("monitor_address", member.MonitorAddress)
+	d.Set("monitor_port", member.MonitorPort)
 	d.Set("region", GetRegion(d, config))
 
 	return nil
@@ -194,18 +262,49 @@ func resourceMemberV2Update(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
-	var updateOpts pools.UpdateMemberOpts
+	var opts pools.UpdateMemberOpts
 	if d.HasChange("name") {
 		name := d.Get("name").(string)
-		updateOpts.Name = &name
+		opts.Name = &name
 	}
 	if d.HasChange("weight") {
 		weight := d.Get("weight").(int)
-		updateOpts.Weight = &weight
+		opts.Weight = &weight
 	}
 	if d.HasChange("admin_state_up") {
 		asu := d.Get("admin_state_up").(bool)
-		updateOpts.AdminStateUp = &asu
+		opts.AdminStateUp = &asu
+	}
+
+	var octaviaOpts MemberMonitorUpdateOptsExt
+	if config.UseOctavia {
+		memberOpts := octaviapools.UpdateMemberOpts{
+			Name:         opts.Name,
+			Weight:       opts.Weight,
+			AdminStateUp: opts.AdminStateUp,
+		}
+		if d.HasChange("backup") {
+			backup := d.Get("backup").(bool)
+			memberOpts.Backup = &backup
+		}
+
+		octaviaOpts.UpdateMemberOptsBuilder = memberOpts
+		if d.HasChange("monitor_address") {
+			monitorAddress := d.Get("monitor_address").(string)
+			octaviaOpts.MonitorAddress = &monitorAddress
+		}
+		if d.HasChange("monitor_port") {
+			monitorPort := d.Get("monitor_port").(int)
+			octaviaOpts.MonitorPort = &monitorPort
+		}
+
+		log.Printf("[DEBUG] Update Options: %#v", octaviaOpts)
+	} else {
+		if err := checkLBV2MemberOctaviaOnly(d); err != nil {
+			return err
+		}
+
+		log.Printf("[DEBUG] Update Options: %#v", opts)
 	}
 
 	// Get a clean copy of the parent pool.
@@ -215,6 +314,13 @@ func resourceMemberV2Update(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Unable to retrieve parent pool %s: %s", poolID, err)
 	}
 
+	if d.HasChange("backup") {
+		err = checkLBV2MemberBackup(parentPool.LBMethod, d.Get("backup").(bool))
+		if err != nil {
+			return err
+		}
+	}
+
 	// Get a clean copy of the member.
 	member, err := pools.GetMember(lbClient, poolID, d.Id()).Extract()
 	if err != nil {
@@ -234,9 +340,13 @@ func resourceMemberV2Update(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	log.Printf("[DEBUG] Updating member %s with options: %#v", d.Id(), updateOpts)
+	log.Printf("[DEBUG] Updating member %s", d.Id())
 	err = resource.Retry(timeout, func() *resource.RetryError {
-		_, err = pools.UpdateMember(lbClient, poolID, d.Id(), updateOpts).Extract()
+		if config.UseOctavia {
+			_, err = octaviapools.UpdateMember(lbClient, poolID, d.Id(), octaviaOpts).Extract()
+		} else {
+			_, err = pools.UpdateMember(lbClient, poolID, d.Id(), opts).Extract()
+		}
 		if err != nil {
 			return checkForRetryableError(err)
 		}
@@ -7,7 +7,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 
 	octaviapools "github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/pools"
 	neutronpools "github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/pools"
@@ -46,53 +45,7 @@ func resourceMembersV2() *schema.Resource {
 			"member": {
 				Type:     schema.TypeSet,
 				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"id": {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-
-						"name": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-
-						"address": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-
-						"protocol_port": {
-							Type:         schema.TypeInt,
-							Required:     true,
-							ValidateFunc: validation.IntBetween(1, 65535),
-						},
-
-						"weight": {
-							Type:         schema.TypeInt,
-							Optional:     true,
-							Default:      1,
-							ValidateFunc: validation.IntBetween(0, 256),
-						},
-
-						"subnet_id": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-
-						"backup": {
-							Type:     schema.TypeBool,
-							Optional: true,
-						},
-
-						"admin_state_up": {
-							Type:     schema.TypeBool,
-							Default:  true,
-							Optional: true,
-						},
-					},
-				},
+				Elem:     lbV2MemberResource(),
 			},
 		},
 	}
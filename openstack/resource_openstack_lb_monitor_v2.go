@@ -131,9 +131,10 @@ func resourceMonitorV2Create(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Unable to retrieve parent openstack_lb_pool_v2 %s: %s", poolID, err)
 	}
 
-	// Wait for parent pool to become active before continuing.
+	// Wait for the parent loadbalancer to become active before continuing.
+	// Concurrent member changes keep it busy, so the create is retried below.
 	timeout := d.Timeout(schema.TimeoutCreate)
-	err = waitForLBV2Pool(lbClient, parentPool, "ACTIVE", getLbPendingStatuses(), timeout)
+	err = waitForLBV2PoolLoadBalancer(lbClient, parentPool, timeout)
 	if err != nil {
 		return err
 	}
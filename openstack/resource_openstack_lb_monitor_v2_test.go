@@ -72,6 +72,32 @@ func TestAccLBV2Monitor_octavia(t *testing.T) {
 	})
 }
 
+func TestAccLBV2Monitor_octaviaConcurrentMembers(t *testing.T) {
+	var monitor monitors.Monitor
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckLB(t)
+			testAccPreCheckUseOctavia(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLBV2MonitorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: TestAccLbV2MonitorConfigOctaviaConcurrentMembers,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2MonitorExists(t, "openstack_lb_monitor_v2.monitor_1", &monitor),
+					resource.TestCheckResourceAttr("openstack_lb_monitor_v2.monitor_1", "type", "PING"),
+					resource.TestCheckResourceAttrSet("openstack_lb_member_v2.member_1.0", "id"),
+					resource.TestCheckResourceAttrSet("openstack_lb_member_v2.member_1.2", "id"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccLBV2Monitor_octavia_udp(t *testing.T) {
 	var monitor monitors.Monitor
 
@@ -422,3 +448,71 @@ resource "openstack_lb_monitor_v2" "monitor_1" {
   }
 }
 `
+
+const TestAccLbV2MonitorConfigOctaviaConcurrentMembers = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+}
+
+resource "openstack_lb_loadbalancer_v2" "loadbalancer_1" {
+  name = "loadbalancer_1"
+  vip_subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+
+  timeouts {
+    create = "15m"
+    update = "15m"
+    delete = "15m"
+  }
+}
+
+resource "openstack_lb_listener_v2" "listener_1" {
+  name = "listener_1"
+  protocol = "HTTP"
+  protocol_port = 8080
+  loadbalancer_id = "${openstack_lb_loadbalancer_v2.loadbalancer_1.id}"
+}
+
+resource "openstack_lb_pool_v2" "pool_1" {
+  name = "pool_1"
+  protocol = "HTTP"
+  lb_method = "ROUND_ROBIN"
+  listener_id = "${openstack_lb_listener_v2.listener_1.id}"
+}
+
+resource "openstack_lb_member_v2" "member_1" {
+  count = 3
+  address = "192.168.199.${count.index + 110}"
+  protocol_port = 8080
+  pool_id = "${openstack_lb_pool_v2.pool_1.id}"
+  subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+
+  timeouts {
+    create = "5m"
+    update = "5m"
+    delete = "5m"
+  }
+}
+
+resource "openstack_lb_monitor_v2" "monitor_1" {
+  name = "monitor_1"
+  type = "PING"
+  delay = 20
+  timeout = 10
+  max_retries = 5
+  pool_id = "${openstack_lb_pool_v2.pool_1.id}"
+
+  timeouts {
+    create = "5m"
+    update = "5m"
+    delete = "5m"
+  }
+}
+`
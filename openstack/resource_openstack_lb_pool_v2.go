@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 
+	octaviapools "github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/pools"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/listeners"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/pools"
 )
@@ -88,15 +89,12 @@ func resourcePoolV2() *schema.Resource {
 			"persistence": {
 				Type:     schema.TypeList,
 				Optional: true,
-				ForceNew: true,
-				Computed: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"type": {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
 							ValidateFunc: validation.StringInSlice([]string{
 								"SOURCE_IP", "HTTP_COOKIE", "APP_COOKIE",
 							}, false),
@@ -105,7 +103,13 @@ func resourcePoolV2() *schema.Resource {
 						"cookie_name": {
 							Type:     schema.TypeString,
 							Optional: true,
-							ForceNew: true,
+						},
+
+						"persistence_timeout": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(1),
 						},
 					},
 				},
@@ -116,6 +120,12 @@ func resourcePoolV2() *schema.Resource {
 				Default:  true,
 				Optional: true,
 			},
+
+			"member": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     lbV2MemberResource(),
+			},
 		},
 	}
 }
@@ -127,32 +137,21 @@ func resourcePoolV2Create(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
+	if _, ok := d.GetOk("member"); ok {
+		if err := checkLBV2PoolMemberOctavia(lbClient); err != nil {
+			return err
+		}
+	}
+
 	adminStateUp := d.Get("admin_state_up").(bool)
 	lbID := d.Get("loadbalancer_id").(string)
 	listenerID := d.Get("listener_id").(string)
-	var persistence pools.SessionPersistence
-	if p, ok := d.GetOk("persistence"); ok {
-		pV := (p.([]interface{}))[0].(map[string]interface{})
-
-		persistence = pools.SessionPersistence{
-			Type: pV["type"].(string),
-		}
-
-		if persistence.Type == "APP_COOKIE" {
-			if pV["cookie_name"].(string) == "" {
-				return fmt.Errorf(
-					"Persistence cookie_name needs to be set if using 'APP_COOKIE' persistence type")
-			}
-			persistence.CookieName = pV["cookie_name"].(string)
-		} else {
-			if pV["cookie_name"].(string) != "" {
-				return fmt.Errorf(
-					"Persistence cookie_name can only be set if using 'APP_COOKIE' persistence type")
-			}
-		}
+	persistence, err := expandLBPoolPersistenceV2(d.Get("persistence").([]interface{}))
+	if err != nil {
+		return err
 	}
 
-	createOpts := pools.CreateOpts{
+	opts := pools.CreateOpts{
 		TenantID:       d.Get("tenant_id").(string),
 		Name:           d.Get("name").(string),
 		Description:    d.Get("description").(string),
@@ -163,9 +162,13 @@ func resourcePoolV2Create(d *schema.ResourceData, meta interface{}) error {
 		AdminStateUp:   &adminStateUp,
 	}
 
+	createOpts := PoolPersistenceCreateOptsExt{
+		CreateOptsBuilder: opts,
+	}
+
 	// Must omit if not set
-	if persistence != (pools.SessionPersistence{}) {
-		createOpts.Persistence = &persistence
+	if persistence.Type != "" {
+		createOpts.Persistence = persistence
 	}
 
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
@@ -215,6 +218,15 @@ func resourcePoolV2Create(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(pool.ID)
 
+	// Add the inline members in a single batch.
+	if v, ok := d.GetOk("member"); ok {
+		members := expandLBMembersV2(v.(*schema.Set), lbClient)
+		err = batchUpdateLBV2PoolMembers(lbClient, pool, members, timeout)
+		if err != nil {
+			return err
+		}
+	}
+
 	return resourcePoolV2Read(d, meta)
 }
 
@@ -225,7 +237,11 @@ func resourcePoolV2Read(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
-	pool, err := pools.Get(lbClient, d.Id()).Extract()
+	var pool struct {
+		pools.Pool
+		Persistence PoolSessionPersistence `json:"session_persistence"`
+	}
+	err = pools.Get(lbClient, d.Id()).ExtractIntoStructPtr(&pool, "pool")
 	if err != nil {
 		return CheckDeleted(d, err, "pool")
 	}
@@ -241,6 +257,26 @@ func resourcePoolV2Read(d *schema.ResourceData, meta interface{}) error {
 	d.Set("persistence", flattenLBPoolPersistenceV2(pool.Persistence))
 	d.Set("region", GetRegion(d, config))
 
+	// Only track the members when they are managed inline. Otherwise
+	// members created by openstack_lb_member_v2 would show up as a diff.
+	if _, ok := d.GetOk("member"); ok {
+		if err := checkLBV2PoolMemberOctavia(lbClient); err != nil {
+			return err
+		}
+
+		allPages, err := octaviapools.ListMembers(lbClient, d.Id(), octaviapools.ListMembersOpts{}).AllPages()
+		if err != nil {
+			return fmt.Errorf("Unable to list members of pool %s: %s", d.Id(), err)
+		}
+
+		members, err := octaviapools.ExtractMembers(allPages)
+		if err != nil {
+			return fmt.Errorf("Unable to retrieve members of pool %s: %s", d.Id(), err)
+		}
+
+		d.Set("member", flattenLBMembersV2(members))
+	}
+
 	return nil
 }
 
@@ -251,21 +287,44 @@ func resourcePoolV2Update(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
-	var updateOpts pools.UpdateOpts
+	if d.HasChange("member") {
+		if err := checkLBV2PoolMemberOctavia(lbClient); err != nil {
+			return err
+		}
+	}
+
+	var hasChange bool
+	var opts pools.UpdateOpts
 	if d.HasChange("lb_method") {
-		updateOpts.LBMethod = pools.LBMethod(d.Get("lb_method").(string))
+		hasChange = true
+		opts.LBMethod = pools.LBMethod(d.Get("lb_method").(string))
 	}
 	if d.HasChange("name") {
+		hasChange = true
 		name := d.Get("name").(string)
-		updateOpts.Name = &name
+		opts.Name = &name
 	}
 	if d.HasChange("description") {
+		hasChange = true
 		description := d.Get("description").(string)
-		updateOpts.Description = &description
+		opts.Description = &description
 	}
 	if d.HasChange("admin_state_up") {
+		hasChange = true
 		asu := d.Get("admin_state_up").(bool)
-		updateOpts.AdminStateUp = &asu
+		opts.AdminStateUp = &asu
+	}
+
+	updateOpts := PoolPersistenceUpdateOptsExt{
+		UpdateOptsBuilder: opts,
+	}
+	if d.HasChange("persistence") {
+		hasChange = true
+		persistence, err := expandLBPoolPersistenceV2(d.Get("persistence").([]interface{}))
+		if err != nil {
+			return err
+		}
+		updateOpts.Persistence = persistence
 	}
 
 	timeout := d.Timeout(schema.TimeoutUpdate)
@@ -276,29 +335,39 @@ func resourcePoolV2Update(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Unable to retrieve pool %s: %s", d.Id(), err)
 	}
 
-	// Wait for pool to become active before continuing
-	err = waitForLBV2Pool(lbClient, pool, "ACTIVE", getLbPendingStatuses(), timeout)
-	if err != nil {
-		return err
-	}
+	if hasChange {
+		// Wait for pool to become active before continuing
+		err = waitForLBV2Pool(lbClient, pool, "ACTIVE", getLbPendingStatuses(), timeout)
+		if err != nil {
+			return err
+		}
+
+		log.Printf("[DEBUG] Updating pool %s with options: %#v", d.Id(), updateOpts)
+		err = resource.Retry(timeout, func() *resource.RetryError {
+			_, err = pools.Update(lbClient, d.Id(), updateOpts).Extract()
+			if err != nil {
+				return checkForRetryableError(err)
+			}
+			return nil
+		})
 
-	log.Printf("[DEBUG] Updating pool %s with options: %#v", d.Id(), updateOpts)
-	err = resource.Retry(timeout, func() *resource.RetryError {
-		_, err = pools.Update(lbClient, d.Id(), updateOpts).Extract()
 		if err != nil {
-			return checkForRetryableError(err)
+			return fmt.Errorf("Unable to update pool %s: %s", d.Id(), err)
 		}
-		return nil
-	})
 
-	if err != nil {
-		return fmt.Errorf("Unable to update pool %s: %s", d.Id(), err)
+		// Wait for pool to become active before continuing
+		err = waitForLBV2Pool(lbClient, pool, "ACTIVE", getLbPendingStatuses(), timeout)
+		if err != nil {
+			return err
+		}
 	}
 
-	// Wait for pool to become active before continuing
-	err = waitForLBV2Pool(lbClient, pool, "ACTIVE", getLbPendingStatuses(), timeout)
-	if err != nil {
-		return err
+	if d.HasChange("member") {
+		members := expandLBMembersV2(d.Get("member").(*schema.Set), lbClient)
+		err = batchUpdateLBV2PoolMembers(lbClient, pool, members, timeout)
+		if err != nil {
+			return err
+		}
 	}
 
 	return resourcePoolV2Read(d, meta)
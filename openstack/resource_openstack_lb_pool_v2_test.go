@@ -61,6 +61,87 @@ func TestAccLBV2Pool_octavia_udp(t *testing.T) {
 	})
 }
 
+func TestAccLBV2Pool_persistence(t *testing.T) {
+	var pool pools.Pool
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckLB(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLBV2PoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLbV2PoolConfigHTTP(`
+  persistence {
+    type = "SOURCE_IP"
+  }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2PoolExists("openstack_lb_pool_v2.pool_1", &pool),
+					resource.TestCheckResourceAttr("openstack_lb_pool_v2.pool_1", "persistence.#", "1"),
+					resource.TestCheckResourceAttr("openstack_lb_pool_v2.pool_1", "persistence.0.type", "SOURCE_IP"),
+					resource.TestCheckResourceAttr("openstack_lb_pool_v2.pool_1", "persistence.0.cookie_name", ""),
+				),
+			},
+			{
+				Config: testAccLbV2PoolConfigHTTP(`
+  persistence {
+    type        = "APP_COOKIE"
+    cookie_name = "testCookie"
+  }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2PoolExists("openstack_lb_pool_v2.pool_1", &pool),
+					resource.TestCheckResourceAttr("openstack_lb_pool_v2.pool_1", "persistence.#", "1"),
+					resource.TestCheckResourceAttr("openstack_lb_pool_v2.pool_1", "persistence.0.type", "APP_COOKIE"),
+					resource.TestCheckResourceAttr("openstack_lb_pool_v2.pool_1", "persistence.0.cookie_name", "testCookie"),
+				),
+			},
+			{
+				Config: testAccLbV2PoolConfigHTTP(""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2PoolExists("openstack_lb_pool_v2.pool_1", &pool),
+					resource.TestCheckResourceAttr("openstack_lb_pool_v2.pool_1", "persistence.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLBV2Pool_octavia_members(t *testing.T) {
+	var pool pools.Pool
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckLB(t)
+			testAccPreCheckUseOctavia(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLBV2PoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLbV2PoolConfigOctaviaMembers(5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2PoolExists("openstack_lb_pool_v2.pool_1", &pool),
+					resource.TestCheckResourceAttr("openstack_lb_pool_v2.pool_1", "member.#", "5"),
+				),
+			},
+			{
+				Config: testAccLbV2PoolConfigOctaviaMembers(2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2PoolExists("openstack_lb_pool_v2.pool_1", &pool),
+					resource.TestCheckResourceAttr("openstack_lb_pool_v2.pool_1", "member.#", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckLBV2PoolDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	lbClient, err := chooseLBV2AccTestClient(config, osRegionName)
@@ -242,3 +323,65 @@ resource "openstack_lb_pool_v2" "pool_1" {
   }
 }
 `
+
+func testAccLbV2PoolConfigHTTP(extra string) string {
+	return fmt.Sprintf(`
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+}
+
+resource "openstack_lb_loadbalancer_v2" "loadbalancer_1" {
+  name = "loadbalancer_1"
+  vip_subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+
+  timeouts {
+    create = "15m"
+    update = "15m"
+    delete = "15m"
+  }
+}
+
+resource "openstack_lb_listener_v2" "listener_1" {
+  name = "listener_1"
+  protocol = "HTTP"
+  protocol_port = 8080
+  loadbalancer_id = "${openstack_lb_loadbalancer_v2.loadbalancer_1.id}"
+}
+
+resource "openstack_lb_pool_v2" "pool_1" {
+  name = "pool_1"
+  protocol = "HTTP"
+  lb_method = "ROUND_ROBIN"
+  listener_id = "${openstack_lb_listener_v2.listener_1.id}"
+%s
+  timeouts {
+    create = "5m"
+    update = "5m"
+    delete = "5m"
+  }
+}
+`, extra)
+}
+
+func testAccLbV2PoolConfigOctaviaMembers(count int) string {
+	var members string
+	for i := 0; i < count; i++ {
+		members += fmt.Sprintf(`
+  member {
+    address       = "192.168.199.%d"
+    protocol_port = 8080
+    subnet_id     = "${openstack_networking_subnet_v2.subnet_1.id}"
+  }
+`, 110+i)
+	}
+
+	return testAccLbV2PoolConfigHTTP(members)
+}
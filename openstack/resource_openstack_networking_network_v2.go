@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 
+	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/attributestags"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/dns"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/external"
@@ -87,6 +88,7 @@ func resourceNetworkingNetworkV2() *schema.Resource {
 			"segments": {
 				Type:     schema.TypeSet,
 				Optional: true,
+				Computed: true,
 				ForceNew: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -268,6 +270,9 @@ func resourceNetworkingNetworkV2Create(d *schema.ResourceData, meta interface{})
 	log.Printf("[DEBUG] openstack_networking_network_v2 create options: %#v", finalCreateOpts)
 	n, err := networks.Create(networkingClient, finalCreateOpts).Extract()
 	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault403); ok && len(segments) > 0 {
+			return fmt.Errorf("Error creating openstack_networking_network_v2: segments can only be set by an admin: %s", err)
+		}
 		return fmt.Errorf("Error creating openstack_networking_network_v2: %s", err)
 	}
 
@@ -312,11 +317,19 @@ func resourceNetworkingNetworkV2Read(d *schema.ResourceData, meta interface{}) e
 
 	var network networkExtended
 
-	err = networks.Get(networkingClient, d.Id()).ExtractInto(&network)
+	r := networks.Get(networkingClient, d.Id())
+	err = r.ExtractInto(&network)
 	if err != nil {
 		return CheckDeleted(d, err, "Error getting openstack_networking_network_v2")
 	}
 
+	// The provider attributes are extracted on their own, so that the
+	// segmentation ID, which may be a string or a number, is parsed.
+	var providerNetwork provider.NetworkProviderExt
+	if err := r.ExtractIntoStructPtr(&providerNetwork, "network"); err != nil {
+		log.Printf("[DEBUG] Unable to extract openstack_networking_network_v2 %s provider attributes: %s", d.Id(), err)
+	}
+
 	log.Printf("[DEBUG] Retrieved openstack_networking_network_v2 %s: %#v", d.Id(), network)
 
 	d.Set("name", network.Name)
@@ -334,6 +347,13 @@ func resourceNetworkingNetworkV2Read(d *schema.ResourceData, meta interface{}) e
 
 	networkingV2ReadAttributesTags(d, network.Tags)
 
+	// Only admins see the provider attributes.
+	if segments := flattenNetworkingNetworkSegmentsV2(providerNetwork); len(segments) > 0 {
+		if err := d.Set("segments", segments); err != nil {
+			log.Printf("[DEBUG] Unable to set openstack_networking_network_v2 %s segments: %s", d.Id(), err)
+		}
+	}
+
 	if err := d.Set("availability_zone_hints", network.AvailabilityZoneHints); err != nil {
 		log.Printf("[DEBUG] Unable to set openstack_networking_network_v2 %s availability_zone_hints: %s", d.Id(), err)
 	}
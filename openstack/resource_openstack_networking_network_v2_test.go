@@ -155,6 +155,29 @@ func TestAccNetworkingV2Network_multipleSegmentMappings(t *testing.T) {
 	})
 }
 
+func TestAccNetworkingV2Network_providerVLAN(t *testing.T) {
+	var network networks.Network
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2NetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2NetworkProviderVLAN,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2NetworkExists("openstack_networking_network_v2.network_1", &network),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_network_v2.network_1", "segments.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccNetworkingV2Network_externalCreate(t *testing.T) {
 	var network networks.Network
 
@@ -451,6 +474,35 @@ func TestAccNetworkingV2Network_portSecurity_enabled(t *testing.T) {
 	})
 }
 
+func TestAccNetworkingV2Network_portSecurity_inherited(t *testing.T) {
+	var network testNetworkWithExtensions
+	var port testPortWithExtensions
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2NetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2NetworkPortSecurityInherited,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2NetworkWithExtensionsExists(
+						"openstack_networking_network_v2.network_1", &network),
+					testAccCheckNetworkingV2NetworkPortSecurityEnabled(&network, false),
+					testAccCheckNetworkingV2PortWithExtensionsExists(
+						"openstack_networking_port_v2.port_1", &port),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_port_v2.port_1", "port_security_enabled", "false"),
+					testAccCheckNetworkingV2PortPortSecurityEnabled(&port, false),
+				),
+			},
+		},
+	})
+}
+
 func TestAccNetworkingV2Network_qos_policy_create(t *testing.T) {
 	var (
 		network   testNetworkWithExtensions
@@ -734,6 +786,19 @@ resource "openstack_networking_network_v2" "network_1" {
 }
 `
 
+const testAccNetworkingV2NetworkProviderVLAN = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+
+  segments {
+    physical_network = "public"
+    network_type = "vlan"
+    segmentation_id = 1001
+  }
+}
+`
+
 const testAccNetworkingV2NetworkExternal = `
 resource "openstack_networking_network_v2" "network_1" {
   name = "network_1"
@@ -777,6 +842,30 @@ resource "openstack_networking_network_v2" "network_1" {
 }
 `
 
+const testAccNetworkingV2NetworkPortSecurityInherited = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  port_security_enabled = "false"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+}
+
+resource "openstack_networking_port_v2" "port_1" {
+  name = "port_1"
+  admin_state_up = "true"
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+
+  fixed_ip {
+    subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+  }
+}
+`
+
 const testAccNetworkingV2NetworkPortSecurityEnabled = `
 resource "openstack_networking_network_v2" "network_1" {
   name = "network_1"
@@ -16,6 +16,9 @@ func resourceNetworkingPortSecGroupAssociateV2() *schema.Resource {
 		Read:   resourceNetworkingPortSecGroupAssociateV2Read,
 		Update: resourceNetworkingPortSecGroupAssociateV2Update,
 		Delete: resourceNetworkingPortSecGroupAssociateV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNetworkingPortSecGroupAssociateV2Import,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"region": {
@@ -206,3 +209,37 @@ func resourceNetworkingPortSecGroupAssociateV2Delete(d *schema.ResourceData, met
 
 	return nil
 }
+
+func resourceNetworkingPortSecGroupAssociateV2Import(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	portID := parts[0]
+
+	var enforce bool
+	if len(parts) == 2 {
+		if parts[1] != "enforce" {
+			return nil, fmt.Errorf("Invalid format specified for openstack_networking_port_secgroup_associate_v2. Format must be <port id>[/enforce]")
+		}
+		enforce = true
+	}
+
+	config := meta.(*Config)
+	networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return nil, fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	port, err := ports.Get(networkingClient, portID).Extract()
+	if err != nil {
+		return nil, fmt.Errorf("Unable to get %s Port: %s", portID, err)
+	}
+
+	log.Printf("[DEBUG] Retrieved Port %s: %+v", portID, port)
+
+	// All current security groups are imported as managed by the association.
+	d.SetId(portID)
+	d.Set("port_id", portID)
+	d.Set("enforce", enforce)
+	d.Set("security_group_ids", port.SecurityGroups)
+
+	return []*schema.ResourceData{d}, nil
+}
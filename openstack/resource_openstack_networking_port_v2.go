@@ -6,6 +6,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/structure"
@@ -32,6 +33,7 @@ func resourceNetworkingPortV2() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
@@ -88,6 +90,14 @@ func resourceNetworkingPortV2() *schema.Resource {
 				Computed: true,
 			},
 
+			"reserve_ip_only": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"device_owner", "device_id", "binding", "no_fixed_ip"},
+			},
+
 			"security_group_ids": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -158,6 +168,7 @@ func resourceNetworkingPortV2() *schema.Resource {
 				Type:     schema.TypeSet,
 				Optional: true,
 				ForceNew: false,
+				Set:      resourceNetworkingPortV2ExtraDHCPOptionHash,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"name": {
@@ -214,6 +225,18 @@ func resourceNetworkingPortV2() *schema.Resource {
 				Computed: true,
 			},
 
+			"mac_learning_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"numa_affinity_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(networkingPortV2NUMAAffinityPolicies, false),
+			},
+
 			"binding": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -248,9 +271,6 @@ func resourceNetworkingPortV2() *schema.Resource {
 							Type:     schema.TypeString,
 							Optional: true,
 							Default:  "normal",
-							ValidateFunc: validation.StringInSlice([]string{
-								"direct", "direct-physical", "macvtap", "normal", "baremetal", "virtio-forwarder",
-							}, true),
 						},
 					},
 				},
@@ -272,9 +292,38 @@ func resourceNetworkingPortV2() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 				ForceNew: false,
-				Computed: true,
+			},
+
+			"device_profile": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"hints": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateNetworkingPortV2Hints,
+				DiffSuppressFunc: diffSuppressJSONObject,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+
+			"wait_for_status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"ACTIVE", "DOWN",
+				}, false),
 			},
 		},
+
+		CustomizeDiff: customdiff.Sequence(
+			networkingPortV2VNICTypeCustomizeDiff,
+			networkingPortV2NUMAAffinityPolicyCustomizeDiff,
+		),
 	}
 }
 
@@ -293,6 +342,14 @@ func resourceNetworkingPortV2Create(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("Cannot have both no_security_groups and security_group_ids set for openstack_networking_port_v2")
 	}
 
+	adminOnlyFields := networkingPortV2AdminOnlyFields(
+		d.Get("binding.0.host_id").(string),
+		d.Get("binding.0.profile").(string),
+		d.Get("device_owner").(string))
+	if err := networkingPortV2Preflight(networkingClient, adminOnlyFields); err != nil {
+		return fmt.Errorf("Error creating openstack_networking_port_v2: %s", err)
+	}
+
 	allowedAddressPairs := d.Get("allowed_address_pairs").(*schema.Set)
 	createOpts := PortCreateOpts{
 		ports.CreateOpts{
@@ -314,6 +371,13 @@ func resourceNetworkingPortV2Create(d *schema.ResourceData, meta interface{}) er
 		createOpts.AdminStateUp = &asu
 	}
 
+	// A port, which only reserves an IP address, gets a dedicated device owner
+	// and is never bound to a host.
+	reserveIPOnly := d.Get("reserve_ip_only").(bool)
+	if reserveIPOnly {
+		createOpts.DeviceOwner = networkingPortV2ReservedDeviceOwner
+	}
+
 	if noSecurityGroups {
 		securityGroups = []string{}
 		createOpts.SecurityGroups = &securityGroups
@@ -347,8 +411,31 @@ func resourceNetworkingPortV2Create(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
+	// Add the MAC learning attribute if specified, so clouds without
+	// the MAC learning extension aren't affected.
+	if v, ok := d.GetOkExists("mac_learning_enabled"); ok {
+		macLearningEnabled := v.(bool)
+		finalCreateOpts = PortMACLearningCreateOptsExt{
+			CreateOptsBuilder:  finalCreateOpts,
+			MACLearningEnabled: &macLearningEnabled,
+		}
+	}
+
+	// Only set numa_affinity_policy if specified, so clouds without
+	// the port-numa-affinity-policy extension aren't affected.
+	if policy := d.Get("numa_affinity_policy").(string); policy != "" {
+		if err := networkingPortV2CheckNUMAAffinityPolicy(networkingClient, policy); err != nil {
+			return fmt.Errorf("Error creating openstack_networking_port_v2: %s", err)
+		}
+
+		finalCreateOpts = PortNUMAAffinityPolicyCreateOptsExt{
+			CreateOptsBuilder:  finalCreateOpts,
+			NUMAAffinityPolicy: policy,
+		}
+	}
+
 	// Add the port binding parameters if specified.
-	if v, ok := d.GetOkExists("binding"); ok {
+	if v, ok := d.GetOkExists("binding"); ok && !reserveIPOnly {
 		for _, raw := range v.([]interface{}) {
 			binding := raw.(map[string]interface{})
 			var profile map[string]interface{}
@@ -358,7 +445,7 @@ func resourceNetworkingPortV2Create(d *schema.ResourceData, meta interface{}) er
 			if len(rawProfile) > 0 {
 				err := json.Unmarshal([]byte(rawProfile), &profile)
 				if err != nil {
-					return fmt.Errorf("Failed to unmarshal the JSON: %s", err)
+					return fmt.Errorf("Error parsing binding.0.profile of openstack_networking_port_v2: %s", err)
 				}
 			}
 
@@ -385,6 +472,28 @@ func resourceNetworkingPortV2Create(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
+	// Only set device_profile if specified, so clouds without
+	// the port-device-profile extension aren't affected.
+	if deviceProfile := d.Get("device_profile").(string); deviceProfile != "" {
+		finalCreateOpts = PortDeviceProfileCreateOptsExt{
+			CreateOptsBuilder: finalCreateOpts,
+			DeviceProfile:     deviceProfile,
+		}
+	}
+
+	// Only set hints if specified, so clouds without
+	// the port-hints extension aren't affected.
+	hints, err := expandNetworkingPortHintsV2(d.Get("hints").(string))
+	if err != nil {
+		return err
+	}
+	if hints != nil {
+		finalCreateOpts = PortHintsCreateOptsExt{
+			CreateOptsBuilder: finalCreateOpts,
+			Hints:             hints,
+		}
+	}
+
 	log.Printf("[DEBUG] openstack_networking_port_v2 create options: %#v", finalCreateOpts)
 
 	// Create a Neutron port and set extra options if they're specified.
@@ -410,6 +519,13 @@ func resourceNetworkingPortV2Create(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("Error waiting for openstack_networking_port_v2 %s to become available: %s", port.ID, err)
 	}
 
+	if status := d.Get("wait_for_status").(string); status != "" {
+		err = networkingPortV2WaitForStatus(networkingClient, port.ID, status, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return fmt.Errorf("Error waiting for openstack_networking_port_v2 %s to become %s: %s", port.ID, status, err)
+		}
+	}
+
 	d.SetId(port.ID)
 
 	tags := networkingV2AttributesTags(d)
@@ -448,6 +564,7 @@ func resourceNetworkingPortV2Read(d *schema.ResourceData, meta interface{}) erro
 	d.Set("mac_address", port.MACAddress)
 	d.Set("tenant_id", port.TenantID)
 	d.Set("device_owner", port.DeviceOwner)
+	d.Set("reserve_ip_only", port.DeviceOwner == networkingPortV2ReservedDeviceOwner)
 	d.Set("device_id", port.DeviceID)
 
 	networkingV2ReadAttributesTags(d, port.Tags)
@@ -465,10 +582,23 @@ func resourceNetworkingPortV2Read(d *schema.ResourceData, meta interface{}) erro
 	d.Set("allowed_address_pairs", flattenNetworkingPortAllowedAddressPairsV2(port.MACAddress, port.AllowedAddressPairs))
 	d.Set("extra_dhcp_option", flattenNetworkingPortDHCPOptsV2(port.ExtraDHCPOptsExt))
 	d.Set("port_security_enabled", port.PortSecurityEnabled)
+
+	// mac_learning_enabled is only returned when the extension is available.
+	if port.MACLearningEnabled != nil {
+		d.Set("mac_learning_enabled", *port.MACLearningEnabled)
+	}
+
+	d.Set("numa_affinity_policy", port.NUMAAffinityPolicy)
+
 	d.Set("binding", flattenNetworkingPortBindingV2(port))
+	if port.VIFType == "binding_failed" {
+		log.Printf("[WARN] openstack_networking_port_v2 %s binding failed on host %q with vnic_type %q", d.Id(), port.HostID, port.VNICType)
+	}
 	d.Set("dns_name", port.DNSName)
 	d.Set("dns_assignment", port.DNSAssignment)
 	d.Set("qos_policy_id", port.QoSPolicyID)
+	d.Set("device_profile", port.DeviceProfile)
+	d.Set("hints", flattenNetworkingPortHintsV2(port.Hints))
 
 	d.Set("region", GetRegion(d, config))
 
@@ -490,6 +620,22 @@ func resourceNetworkingPortV2Update(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("Cannot have both no_security_groups and security_group_ids set for openstack_networking_port_v2")
 	}
 
+	// Only check the admin-only attributes, which are changed.
+	var hostID, profile, deviceOwner string
+	if d.HasChange("binding.0.host_id") {
+		hostID = d.Get("binding.0.host_id").(string)
+	}
+	if d.HasChange("binding.0.profile") {
+		profile = d.Get("binding.0.profile").(string)
+	}
+	if d.HasChange("device_owner") {
+		deviceOwner = d.Get("device_owner").(string)
+	}
+	adminOnlyFields := networkingPortV2AdminOnlyFields(hostID, profile, deviceOwner)
+	if err := networkingPortV2Preflight(networkingClient, adminOnlyFields); err != nil {
+		return fmt.Errorf("Error updating openstack_networking_port_v2 %s: %s", d.Id(), err)
+	}
+
 	var hasChange bool
 	var updateOpts ports.UpdateOpts
 
@@ -609,7 +755,7 @@ func resourceNetworkingPortV2Update(d *schema.ResourceData, meta interface{}) er
 				if len(rawProfile) > 0 {
 					err := json.Unmarshal([]byte(rawProfile), &profile)
 					if err != nil {
-						return fmt.Errorf("Failed to unmarshal the JSON: %s", err)
+						return fmt.Errorf("Error parsing binding.0.profile of openstack_networking_port_v2 %s: %s", d.Id(), err)
 					}
 					if profile == nil {
 						profile = map[string]interface{}{}
@@ -639,6 +785,7 @@ func resourceNetworkingPortV2Update(d *schema.ResourceData, meta interface{}) er
 	if d.HasChange("qos_policy_id") {
 		hasChange = true
 
+		// An empty QoS policy ID is sent as null, which detaches the policy.
 		qosPolicyID := d.Get("qos_policy_id").(string)
 		finalUpdateOpts = policies.PortUpdateOptsExt{
 			UpdateOptsBuilder: finalUpdateOpts,
@@ -646,6 +793,45 @@ func resourceNetworkingPortV2Update(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
+	if d.HasChange("mac_learning_enabled") {
+		hasChange = true
+
+		macLearningEnabled := d.Get("mac_learning_enabled").(bool)
+		finalUpdateOpts = PortMACLearningUpdateOptsExt{
+			UpdateOptsBuilder:  finalUpdateOpts,
+			MACLearningEnabled: &macLearningEnabled,
+		}
+	}
+
+	if d.HasChange("numa_affinity_policy") {
+		hasChange = true
+
+		policy := d.Get("numa_affinity_policy").(string)
+		if policy != "" {
+			if err := networkingPortV2CheckNUMAAffinityPolicy(networkingClient, policy); err != nil {
+				return fmt.Errorf("Error updating openstack_networking_port_v2 %s: %s", d.Id(), err)
+			}
+		}
+
+		finalUpdateOpts = PortNUMAAffinityPolicyUpdateOptsExt{
+			UpdateOptsBuilder:  finalUpdateOpts,
+			NUMAAffinityPolicy: &policy,
+		}
+	}
+
+	if d.HasChange("hints") {
+		hasChange = true
+
+		hints, err := expandNetworkingPortHintsV2(d.Get("hints").(string))
+		if err != nil {
+			return err
+		}
+		finalUpdateOpts = PortHintsUpdateOptsExt{
+			UpdateOptsBuilder: finalUpdateOpts,
+			Hints:             hints,
+		}
+	}
+
 	// At this point, perform the update for all "standard" port changes.
 	if hasChange {
 		log.Printf("[DEBUG] openstack_networking_port_v2 %s update options: %#v", d.Id(), finalUpdateOpts)
@@ -655,6 +841,13 @@ func resourceNetworkingPortV2Update(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
+	if status := d.Get("wait_for_status").(string); status != "" && (d.HasChange("binding") || d.HasChange("wait_for_status")) {
+		err = networkingPortV2WaitForStatus(networkingClient, d.Id(), status, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return fmt.Errorf("Error waiting for openstack_networking_port_v2 %s to become %s: %s", d.Id(), status, err)
+		}
+	}
+
 	// Next, perform any required updates to the tags.
 	if d.HasChange("tags") {
 		tags := networkingV2UpdateAttributesTags(d)
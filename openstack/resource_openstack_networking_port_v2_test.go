@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/portsbinding"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/portsecurity"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/qos/policies"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/groups"
@@ -19,6 +20,7 @@ type testPortWithExtensions struct {
 	ports.Port
 	portsecurity.PortSecurityExt
 	policies.QoSPolicyExt
+	portsbinding.PortsBindingExt
 }
 
 func TestAccNetworkingV2Port_basic(t *testing.T) {
@@ -130,6 +132,7 @@ func TestAccNetworkingV2Port_allowedAddressPairs(t *testing.T) {
 					testAccCheckNetworkingV2PortExists("openstack_networking_port_v2.instance_port", &instancePort),
 					testAccCheckNetworkingV2PortCountAllowedAddressPairs(&instancePort, 2),
 					resource.TestCheckResourceAttr("openstack_networking_port_v2.vrrp_port_1", "description", "test vrrp port"),
+					testAccCheckNetworkingV2PortDescription(&vrrpPort1, "test vrrp port"),
 				),
 			},
 			{
@@ -142,6 +145,7 @@ func TestAccNetworkingV2Port_allowedAddressPairs(t *testing.T) {
 					testAccCheckNetworkingV2PortExists("openstack_networking_port_v2.instance_port", &instancePort),
 					testAccCheckNetworkingV2PortCountAllowedAddressPairs(&instancePort, 2),
 					resource.TestCheckResourceAttr("openstack_networking_port_v2.vrrp_port_1", "description", ""),
+					testAccCheckNetworkingV2PortDescription(&vrrpPort1, ""),
 				),
 			},
 			{
@@ -365,6 +369,46 @@ func TestAccNetworkingV2Port_updateSecurityGroups(t *testing.T) {
 	})
 }
 
+func TestAccNetworkingV2Port_allSecurityGroupIDs(t *testing.T) {
+	var port ports.Port
+	var secgroup1, secgroup2 groups.SecGroup
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2PortDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2PortUpdateSecurityGroups2,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2PortExists("openstack_networking_port_v2.port_1", &port),
+					testAccCheckNetworkingV2SecGroupExists(
+						"openstack_networking_secgroup_v2.secgroup_1", &secgroup1),
+					testAccCheckNetworkingV2SecGroupExists(
+						"openstack_networking_secgroup_v2.secgroup_2", &secgroup2),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_port_v2.port_1", "all_security_group_ids.#", "1"),
+					testAccCheckNetworkingV2PortAddSecurityGroup(&port, &secgroup2),
+				),
+			},
+			{
+				Config: testAccNetworkingV2PortUpdateSecurityGroups2,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2PortExists("openstack_networking_port_v2.port_1", &port),
+					testAccCheckNetworkingV2PortCountSecurityGroups(&port, 2),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_port_v2.port_1", "security_group_ids.#", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_port_v2.port_1", "all_security_group_ids.#", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccNetworkingV2Port_noSecurityGroups(t *testing.T) {
 	var network networks.Network
 	var port ports.Port
@@ -517,6 +561,60 @@ func TestAccNetworkingV2Port_createExtraDHCPOpts(t *testing.T) {
 	})
 }
 
+func TestAccNetworkingV2Port_extraDHCPOptsIPv6(t *testing.T) {
+	var port ports.Port
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2PortDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2PortExtraDhcpOptsIPv6,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2PortExists("openstack_networking_port_v2.port_1", &port),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_port_v2.port_1", "extra_dhcp_option.#", "2"),
+				),
+			},
+			{
+				Config:   testAccNetworkingV2PortExtraDhcpOptsIPv6,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccNetworkingV2Port_extraDHCPOptsReordered(t *testing.T) {
+	var port ports.Port
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2PortDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2PortCreateExtraDhcpOpts,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2PortExists("openstack_networking_port_v2.port_1", &port),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_port_v2.port_1", "extra_dhcp_option.#", "2"),
+				),
+			},
+			{
+				Config:   testAccNetworkingV2PortCreateExtraDhcpOptsReordered,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccNetworkingV2Port_updateExtraDHCPOpts(t *testing.T) {
 	var network networks.Network
 	var subnet subnets.Subnet
@@ -836,6 +934,8 @@ func TestAccNetworkingV2Port_portBinding_create(t *testing.T) {
 						"openstack_networking_port_v2.port_1", "binding.#", "1"),
 					resource.TestCheckResourceAttr(
 						"openstack_networking_port_v2.port_1", "binding.0.vnic_type", "normal"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_networking_port_v2.port_1", "binding.0.vif_type"),
 				),
 			},
 		},
@@ -999,6 +1099,204 @@ func TestAccNetworkingV2Port_qos_policy_update(t *testing.T) {
 	})
 }
 
+func TestAccNetworkingV2Port_qos_policy_detach(t *testing.T) {
+	var (
+		port      testPortWithExtensions
+		qosPolicy policies.Policy
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2PortDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2PortQosPolicy,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2PortWithExtensionsExists(
+						"openstack_networking_port_v2.port_1", &port),
+					testAccCheckNetworkingV2QoSPolicyExists(
+						"openstack_networking_qos_policy_v2.qos_policy_1", &qosPolicy),
+					resource.TestCheckResourceAttrSet(
+						"openstack_networking_port_v2.port_1", "qos_policy_id"),
+				),
+			},
+			{
+				Config: testAccNetworkingV2PortQosPolicyDetached,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2PortWithExtensionsExists(
+						"openstack_networking_port_v2.port_1", &port),
+					testAccCheckNetworkingV2PortQoSPolicyDetached(&port),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_port_v2.port_1", "qos_policy_id", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkingV2Port_deviceProfile(t *testing.T) {
+	var port testPortWithExtensions
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckDeviceProfile(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2PortDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2PortDeviceProfile(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2PortWithExtensionsExists(
+						"openstack_networking_port_v2.port_1", &port),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_port_v2.port_1", "device_profile", osDeviceProfileName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkingV2Port_hints(t *testing.T) {
+	var port testPortWithExtensions
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2PortDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2PortHints,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2PortWithExtensionsExists(
+						"openstack_networking_port_v2.port_1", &port),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_port_v2.port_1", "hints",
+						`{"openvswitch":{"other_config":{"tx-steering":"hash"}}}`),
+				),
+			},
+			{
+				Config: testAccNetworkingV2PortBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2PortWithExtensionsExists(
+						"openstack_networking_port_v2.port_1", &port),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_port_v2.port_1", "hints", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkingV2Port_macLearning(t *testing.T) {
+	var port testPortWithExtensions
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2PortDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2PortMACLearning(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2PortWithExtensionsExists(
+						"openstack_networking_port_v2.port_1", &port),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_port_v2.port_1", "mac_learning_enabled", "true"),
+				),
+			},
+			{
+				Config: testAccNetworkingV2PortMACLearning(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2PortWithExtensionsExists(
+						"openstack_networking_port_v2.port_1", &port),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_port_v2.port_1", "mac_learning_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkingV2Port_waitForStatus(t *testing.T) {
+	var port testPortWithExtensions
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2PortDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2PortWaitForStatus(""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2PortWithExtensionsExists(
+						"openstack_networking_port_v2.port_1", &port),
+				),
+			},
+			{
+				Config: testAccNetworkingV2PortWaitForStatus("ACTIVE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2PortWithExtensionsExists(
+						"openstack_networking_port_v2.port_1", &port),
+					testAccCheckNetworkingV2PortStatus(&port, "ACTIVE"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_port_v2.port_1", "wait_for_status", "ACTIVE"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkingV2Port_reserveIPOnly(t *testing.T) {
+	var port testPortWithExtensions
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2PortDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2PortReserveIPOnly,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2PortWithExtensionsExists(
+						"openstack_networking_port_v2.port_1", &port),
+					testAccCheckNetworkingV2PortNotBound(&port),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_port_v2.port_1", "reserve_ip_only", "true"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_port_v2.port_1", "device_owner", "reserved"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_port_v2.port_1", "all_fixed_ips.0", "192.168.199.23"),
+				),
+			},
+			{
+				Config:   testAccNetworkingV2PortReserveIPOnly,
+				PlanOnly: true,
+			},
+			{
+				// The same port configured through device_owner.
+				Config:   testAccNetworkingV2PortReservedDeviceOwner,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func testAccCheckNetworkingV2PortDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	networkingClient, err := config.NetworkingV2Client(osRegionName)
@@ -1080,26 +1378,93 @@ func testAccCheckNetworkingV2PortWithExtensionsExists(
 			return fmt.Errorf("Port not found")
 		}
 
-		*port = p
+		*port = p
+
+		return nil
+	}
+}
+
+func testAccCheckNetworkingV2PortNotBound(port *testPortWithExtensions) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if port.HostID != "" {
+			return fmt.Errorf("Port %s is bound to host %s", port.ID, port.HostID)
+		}
+
+		if port.DeviceID != "" {
+			return fmt.Errorf("Port %s is attached to device %s", port.ID, port.DeviceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckNetworkingV2PortQoSPolicyDetached(port *testPortWithExtensions) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if port.QoSPolicyID != "" {
+			return fmt.Errorf("Port %s still has QoS policy %s", port.ID, port.QoSPolicyID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckNetworkingV2PortStatus(port *testPortWithExtensions, status string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if port.Status != status {
+			return fmt.Errorf("Port %s has status %s, expected %s", port.ID, port.Status, status)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckNetworkingV2PortCountFixedIPs(port *ports.Port, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if len(port.FixedIPs) != expected {
+			return fmt.Errorf("Expected %d Fixed IPs, got %d", expected, len(port.FixedIPs))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckNetworkingV2PortCountSecurityGroups(port *ports.Port, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if len(port.SecurityGroups) != expected {
+			return fmt.Errorf("Expected %d Security Groups, got %d", expected, len(port.SecurityGroups))
+		}
 
 		return nil
 	}
 }
 
-func testAccCheckNetworkingV2PortCountFixedIPs(port *ports.Port, expected int) resource.TestCheckFunc {
+// testAccCheckNetworkingV2PortAddSecurityGroup adds a security group to the
+// port outside of Terraform.
+func testAccCheckNetworkingV2PortAddSecurityGroup(port *ports.Port, secgroup *groups.SecGroup) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		if len(port.FixedIPs) != expected {
-			return fmt.Errorf("Expected %d Fixed IPs, got %d", expected, len(port.FixedIPs))
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.NetworkingV2Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+		}
+
+		securityGroups := append(port.SecurityGroups, secgroup.ID)
+		updateOpts := ports.UpdateOpts{
+			SecurityGroups: &securityGroups,
+		}
+
+		if _, err := ports.Update(networkingClient, port.ID, updateOpts).Extract(); err != nil {
+			return fmt.Errorf("Error adding security group %s to port %s: %s", secgroup.ID, port.ID, err)
 		}
 
 		return nil
 	}
 }
 
-func testAccCheckNetworkingV2PortCountSecurityGroups(port *ports.Port, expected int) resource.TestCheckFunc {
+func testAccCheckNetworkingV2PortDescription(port *ports.Port, expected string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		if len(port.SecurityGroups) != expected {
-			return fmt.Errorf("Expected %d Security Groups, got %d", expected, len(port.SecurityGroups))
+		if port.Description != expected {
+			return fmt.Errorf("Expected description %q, got %q", expected, port.Description)
 		}
 
 		return nil
@@ -2188,6 +2553,89 @@ resource "openstack_networking_port_v2" "port_1" {
 }
 `
 
+const testAccNetworkingV2PortCreateExtraDhcpOptsReordered = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+}
+
+resource "openstack_networking_port_v2" "port_1" {
+  name = "port_1"
+  admin_state_up = "true"
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+
+  fixed_ip {
+    subnet_id =  "${openstack_networking_subnet_v2.subnet_1.id}"
+    ip_address = "192.168.199.23"
+  }
+
+  extra_dhcp_option {
+    name = "optionB"
+    value = "valueB"
+  }
+
+  extra_dhcp_option {
+    name = "optionA"
+    value = "valueA"
+  }
+}
+`
+
+const testAccNetworkingV2PortExtraDhcpOptsIPv6 = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_2" {
+  name = "subnet_2"
+  cidr = "fd00:0:0:1::/64"
+  ip_version = 6
+  ipv6_address_mode = "dhcpv6-stateful"
+  ipv6_ra_mode = "dhcpv6-stateful"
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+}
+
+resource "openstack_networking_port_v2" "port_1" {
+  name = "port_1"
+  admin_state_up = "true"
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+
+  fixed_ip {
+    subnet_id =  "${openstack_networking_subnet_v2.subnet_1.id}"
+  }
+
+  fixed_ip {
+    subnet_id =  "${openstack_networking_subnet_v2.subnet_2.id}"
+  }
+
+  extra_dhcp_option {
+    name = "dns-server"
+    value = "192.168.199.2"
+  }
+
+  extra_dhcp_option {
+    name = "dns-server"
+    value = "fd00:0:0:1::2"
+    ip_version = 6
+  }
+}
+`
+
 const testAccNetworkingV2PortUpdateExtraDhcpOpts1 = `
 resource "openstack_networking_network_v2" "network_1" {
   name = "network_1"
@@ -2732,3 +3180,204 @@ resource "openstack_networking_port_v2" "port_1" {
   qos_policy_id  = "${openstack_networking_qos_policy_v2.qos_policy_1.id}"
 }
 `
+
+const testAccNetworkingV2PortHints = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+}
+
+resource "openstack_networking_port_v2" "port_1" {
+  name = "port_1"
+  admin_state_up = "true"
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+
+  hints = <<EOF
+{
+  "openvswitch": {
+    "other_config": {
+      "tx-steering": "hash"
+    }
+  }
+}
+EOF
+
+  fixed_ip {
+    subnet_id =  "${openstack_networking_subnet_v2.subnet_1.id}"
+    ip_address = "192.168.199.23"
+  }
+}
+`
+
+const testAccNetworkingV2PortReserveIPOnly = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+}
+
+resource "openstack_networking_port_v2" "port_1" {
+  name = "port_1"
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+  reserve_ip_only = true
+
+  fixed_ip {
+    subnet_id =  "${openstack_networking_subnet_v2.subnet_1.id}"
+    ip_address = "192.168.199.23"
+  }
+}
+`
+
+const testAccNetworkingV2PortReservedDeviceOwner = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+}
+
+resource "openstack_networking_port_v2" "port_1" {
+  name = "port_1"
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+  device_owner = "reserved"
+
+  fixed_ip {
+    subnet_id =  "${openstack_networking_subnet_v2.subnet_1.id}"
+    ip_address = "192.168.199.23"
+  }
+}
+`
+
+func testAccNetworkingV2PortDeviceProfile() string {
+	return fmt.Sprintf(`
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+}
+
+resource "openstack_networking_port_v2" "port_1" {
+  name = "port_1"
+  admin_state_up = "true"
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+  device_profile = "%s"
+
+  binding {
+    vnic_type = "accelerator-direct"
+  }
+
+  fixed_ip {
+    subnet_id =  "${openstack_networking_subnet_v2.subnet_1.id}"
+    ip_address = "192.168.199.23"
+  }
+}
+`, osDeviceProfileName)
+}
+
+func testAccNetworkingV2PortMACLearning(enabled bool) string {
+	return fmt.Sprintf(`
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+}
+
+resource "openstack_networking_port_v2" "port_1" {
+  name = "port_1"
+  admin_state_up = "true"
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+  mac_learning_enabled = %t
+
+  fixed_ip {
+    subnet_id =  "${openstack_networking_subnet_v2.subnet_1.id}"
+    ip_address = "192.168.199.23"
+  }
+}
+`, enabled)
+}
+
+const testAccNetworkingV2PortQosPolicyDetached = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+}
+
+resource "openstack_networking_qos_policy_v2" "qos_policy_1" {
+  name = "qos_policy_1"
+}
+
+resource "openstack_networking_port_v2" "port_1" {
+  name = "port_1"
+  admin_state_up = "true"
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+
+  fixed_ip {
+    subnet_id =  "${openstack_networking_subnet_v2.subnet_1.id}"
+    ip_address = "192.168.199.23"
+  }
+}
+`
+
+// testAccNetworkingV2PortWaitForStatus omits wait_for_status if status is
+// empty, because an empty value doesn't pass the validation.
+func testAccNetworkingV2PortWaitForStatus(status string) string {
+	var waitForStatus string
+	if status != "" {
+		waitForStatus = fmt.Sprintf("wait_for_status = %q", status)
+	}
+
+	return fmt.Sprintf(`
+resource "openstack_networking_port_v2" "port_1" {
+  name = "port_1"
+  admin_state_up = "true"
+  network_id = "%s"
+  %s
+}
+
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+
+  network {
+    port = "${openstack_networking_port_v2.port_1.id}"
+  }
+}
+`, osNetworkID, waitForStatus)
+}
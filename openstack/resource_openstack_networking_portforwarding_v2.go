@@ -48,13 +48,33 @@ func resourceNetworkingPortForwardingV2() *schema.Resource {
 			},
 
 			"internal_port": {
-				Type:     schema.TypeInt,
-				Required: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"internal_port", "internal_port_range"},
+			},
+
+			"internal_port_range": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"internal_port", "internal_port_range"},
+				ValidateFunc: validateNetworkingPortForwardingV2PortRange,
 			},
 
 			"external_port": {
-				Type:     schema.TypeInt,
-				Required: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"external_port", "external_port_range"},
+			},
+
+			"external_port_range": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"external_port", "external_port_range"},
+				ValidateFunc: validateNetworkingPortForwardingV2PortRange,
 			},
 
 			"protocol": {
@@ -78,16 +98,19 @@ func resourceNetworkPortForwardingV2Create(d *schema.ResourceData, meta interfac
 	}
 
 	fipID := d.Get("floatingip_id").(string)
-	createOpts := portforwarding.CreateOpts{
-		InternalIPAddress: d.Get("internal_ip_address").(string),
-		ExternalPort:      d.Get("external_port").(int),
-		InternalPort:      d.Get("internal_port").(int),
-		InternalPortID:    d.Get("internal_port_id").(string),
-		Protocol:          d.Get("protocol").(string),
+	createOpts := PortForwardingCreateOptsExt{
+		CreateOptsBuilder: portforwarding.CreateOpts{
+			InternalIPAddress: d.Get("internal_ip_address").(string),
+			ExternalPort:      d.Get("external_port").(int),
+			InternalPort:      d.Get("internal_port").(int),
+			InternalPortID:    d.Get("internal_port_id").(string),
+			Protocol:          d.Get("protocol").(string),
+		},
+		Description:       d.Get("description").(string),
+		InternalPortRange: d.Get("internal_port_range").(string),
+		ExternalPortRange: d.Get("external_port_range").(string),
 	}
 
-	// TODO: add description.
-
 	log.Printf("[DEBUG] openstack_networking_portforwarding_v2 create options: %#v", createOpts)
 
 	pf, err := portforwarding.Create(networkingClient, fipID, createOpts).Extract()
@@ -125,7 +148,8 @@ func resourceNetworkPortForwardingV2Read(d *schema.ResourceData, meta interface{
 
 	fipID := d.Get("floatingip_id").(string)
 
-	pf, err := portforwarding.Get(networkingClient, fipID, d.Id()).Extract()
+	var pf portForwardingExtended
+	err = portforwarding.Get(networkingClient, fipID, d.Id()).ExtractInto(&pf)
 	if err != nil {
 		return CheckDeleted(d, err, "Error getting openstack_networking_portforwarding_v2")
 	}
@@ -136,12 +160,13 @@ func resourceNetworkPortForwardingV2Read(d *schema.ResourceData, meta interface{
 	d.Set("internal_port_id", pf.InternalPortID)
 	d.Set("internal_ip_address", pf.InternalIPAddress)
 	d.Set("internal_port", pf.InternalPort)
+	d.Set("internal_port_range", pf.InternalPortRange)
 	d.Set("external_port", pf.ExternalPort)
+	d.Set("external_port_range", pf.ExternalPortRange)
 	d.Set("protocol", pf.Protocol)
+	d.Set("description", pf.Description)
 	d.Set("region", GetRegion(d, config))
 
-	// TODO: add description.
-
 	return nil
 }
 
@@ -154,8 +179,9 @@ func resourceNetworkPortForwardingV2Update(d *schema.ResourceData, meta interfac
 
 	var hasChange bool
 	var updateOpts portforwarding.UpdateOpts
+	var updateOptsExt PortForwardingUpdateOptsExt
 
-	fipID := d.Get("floating_IP_ID").(string)
+	fipID := d.Get("floatingip_id").(string)
 
 	if d.HasChange("internal_port_id") {
 		hasChange = true
@@ -169,22 +195,37 @@ func resourceNetworkPortForwardingV2Update(d *schema.ResourceData, meta interfac
 		updateOpts.ExternalPort = externalPort
 	}
 
+	if d.HasChange("external_port_range") {
+		hasChange = true
+		updateOptsExt.ExternalPortRange = d.Get("external_port_range").(string)
+	}
+
 	if d.HasChange("internal_port") {
 		hasChange = true
 		internalPort := d.Get("internal_port").(int)
 		updateOpts.InternalPort = internalPort
 	}
+
+	if d.HasChange("internal_port_range") {
+		hasChange = true
+		updateOptsExt.InternalPortRange = d.Get("internal_port_range").(string)
+	}
 	if d.HasChange("protocol") {
 		hasChange = true
 		protocol := d.Get("protocol").(string)
 		updateOpts.Protocol = protocol
 	}
 
-	// TODO: add description.
+	if d.HasChange("description") {
+		hasChange = true
+		description := d.Get("description").(string)
+		updateOptsExt.Description = &description
+	}
 
 	if hasChange {
-		log.Printf("[DEBUG] openstack_networking_portforwarding_v2 %s update options: %#v", d.Id(), updateOpts)
-		_, err = portforwarding.Update(networkingClient, fipID, d.Id(), updateOpts).Extract()
+		updateOptsExt.UpdateOptsBuilder = updateOpts
+		log.Printf("[DEBUG] openstack_networking_portforwarding_v2 %s update options: %#v", d.Id(), updateOptsExt)
+		_, err = portforwarding.Update(networkingClient, fipID, d.Id(), updateOptsExt).Extract()
 		if err != nil {
 			return fmt.Errorf("Error updating openstack_networking_portforwarding_v2 %s: %s", d.Id(), err)
 		}
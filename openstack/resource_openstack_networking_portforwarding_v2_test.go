@@ -48,6 +48,31 @@ func TestAccNetworkingV2Portforwarding_basic(t *testing.T) {
 	})
 }
 
+func TestAccNetworkingV2Portforwarding_portRange(t *testing.T) {
+	var pf portforwarding.PortForwarding
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckPortForwarding(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2PortForwardingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2PortForwardingPortRange,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2PortForwardingExists("openstack_networking_portforwarding_v2.pf_1", "openstack_networking_floatingip_v2.fip_1", &pf),
+					resource.TestCheckResourceAttr("openstack_networking_portforwarding_v2.pf_1", "internal_port_range", "8000:8010"),
+					resource.TestCheckResourceAttr("openstack_networking_portforwarding_v2.pf_1", "external_port_range", "9000:9010"),
+					resource.TestCheckResourceAttr("openstack_networking_portforwarding_v2.pf_1", "description", "port range"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckNetworkingV2PortForwardingDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	networkClient, err := config.NetworkingV2Client(osRegionName)
@@ -161,3 +186,59 @@ resource "openstack_networking_portforwarding_v2" "pf_1" {
   depends_on = [openstack_networking_port_v2.port_1, openstack_networking_floatingip_v2.fip_1]
 }
 `, osExtGwID, osPoolName)
+
+var testAccNetworkingV2PortForwardingPortRange = fmt.Sprintf(`
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  description = "Network"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  gateway_ip = "192.168.199.1"
+  enable_dhcp = "false"
+  ip_version = 4
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+}
+
+resource "openstack_networking_router_v2" "router_1" {
+  name = "router_1"
+  external_network_id = "%s"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_port_v2" "port_1" {
+  admin_state_up = "true"
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+
+  fixed_ip {
+    subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+    ip_address = "192.168.199.3"
+  }
+}
+
+resource "openstack_networking_router_interface_v2" "router_interface_1" {
+  router_id = "${openstack_networking_router_v2.router_1.id}"
+  port_id = "${openstack_networking_port_v2.port_1.id}"
+}
+
+resource "openstack_networking_floatingip_v2" "fip_1" {
+  description = "test"
+  port_id = ""
+  pool = "%s"
+  depends_on = [openstack_networking_router_interface_v2.router_interface_1]
+}
+
+resource "openstack_networking_portforwarding_v2" "pf_1" {
+  protocol = "tcp"
+  internal_ip_address = "${openstack_networking_port_v2.port_1.fixed_ip[0].ip_address}"
+  internal_port_range = "8000:8010"
+  internal_port_id = "${openstack_networking_port_v2.port_1.id}"
+  external_port_range = "9000:9010"
+  description = "port range"
+  floatingip_id = "${openstack_networking_floatingip_v2.fip_1.id}"
+  depends_on = [openstack_networking_port_v2.port_1, openstack_networking_floatingip_v2.fip_1]
+}
+`, osExtGwID, osPoolName)
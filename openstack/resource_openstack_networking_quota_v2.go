@@ -3,7 +3,6 @@ package openstack
 import (
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/quotas"
@@ -17,7 +16,7 @@ func resourceNetworkingQuotaV2() *schema.Resource {
 		Update: resourceNetworkingQuotaV2Update,
 		Delete: schema.RemoveFromState,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importQuota("openstack_networking_quota_v2"),
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -133,8 +132,7 @@ func resourceNetworkingQuotaV2Create(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("Error creating openstack_networking_quota_v2: %s", err)
 	}
 
-	id := fmt.Sprintf("%s/%s", projectID, region)
-	d.SetId(id)
+	d.SetId(fmt.Sprintf("%s/%s", projectID, region))
 
 	log.Printf("[DEBUG] Created openstack_networking_quota_v2 %#v", q)
 
@@ -143,17 +141,26 @@ func resourceNetworkingQuotaV2Create(d *schema.ResourceData, meta interface{}) e
 
 func resourceNetworkingQuotaV2Read(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
-	region := GetRegion(d, config)
+
+	// Depending on the provider version the resource was created, the resource id
+	// can be either <project_id> or <project_id>/<region>. A legacy id gets the
+	// region appended.
+	id, err := quotaID("openstack_networking_quota_v2", d.Id(), GetRegion(d, config))
+	if err != nil {
+		return err
+	}
+	d.SetId(id)
+
+	projectID, region, err := parseQuotaID("openstack_networking_quota_v2", id)
+	if err != nil {
+		return err
+	}
+
 	networkingClient, err := config.NetworkingV2Client(region)
 	if err != nil {
 		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
-	// Depending on the provider version the resource was created, the resource id
-	// can be either <project_id> or <project_id>/<region>. This parses the project_id
-	// in both cases
-	projectID := strings.Split(d.Id(), "/")[0]
-
 	q, err := quotas.Get(networkingClient, projectID).Extract()
 	if err != nil {
 		return CheckDeleted(d, err, "Error retrieving openstack_networking_quota_v2")
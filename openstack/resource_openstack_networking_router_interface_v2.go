@@ -3,6 +3,7 @@ package openstack
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -17,6 +18,7 @@ func resourceNetworkingRouterInterfaceV2() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceNetworkingRouterInterfaceV2Create,
 		Read:   resourceNetworkingRouterInterfaceV2Read,
+		Update: resourceNetworkingRouterInterfaceV2Update,
 		Delete: resourceNetworkingRouterInterfaceV2Delete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -54,6 +56,12 @@ func resourceNetworkingRouterInterfaceV2() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+
+			"force_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -135,6 +143,11 @@ func resourceNetworkingRouterInterfaceV2Read(d *schema.ResourceData, meta interf
 	return nil
 }
 
+func resourceNetworkingRouterInterfaceV2Update(d *schema.ResourceData, meta interface{}) error {
+	// Only force_destroy can be updated and it's used only during delete.
+	return resourceNetworkingRouterInterfaceV2Read(d, meta)
+}
+
 func resourceNetworkingRouterInterfaceV2Delete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	networkingClient, err := config.NetworkingV2Client(GetRegion(d, config))
@@ -142,6 +155,12 @@ func resourceNetworkingRouterInterfaceV2Delete(d *schema.ResourceData, meta inte
 		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
+	if d.Get("force_destroy").(bool) {
+		if err := networkingRouterInterfaceV2DeleteLingeringPorts(networkingClient, d.Id()); err != nil {
+			return err
+		}
+	}
+
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"ACTIVE"},
 		Target:     []string{"DELETED"},
@@ -153,6 +172,16 @@ func resourceNetworkingRouterInterfaceV2Delete(d *schema.ResourceData, meta inte
 
 	_, err = stateConf.WaitForState()
 	if err != nil {
+		subnetPorts, portsErr := networkingRouterInterfaceV2SubnetPorts(networkingClient, d.Id())
+		if portsErr == nil && len(subnetPorts) > 0 {
+			portIDs := make([]string, len(subnetPorts))
+			for i, port := range subnetPorts {
+				portIDs[i] = port.ID
+			}
+			return fmt.Errorf("Error waiting for openstack_networking_router_interface_v2 %s to delete: %s. "+
+				"The following ports still use the interface subnets: %s", d.Id(), err, strings.Join(portIDs, ", "))
+		}
+
 		return fmt.Errorf("Error waiting for openstack_networking_router_interface_v2 %s to delete: %s", d.Id(), err)
 	}
 
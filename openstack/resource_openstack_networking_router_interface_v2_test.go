@@ -93,6 +93,31 @@ func TestAccNetworkingV2RouterInterface_timeout(t *testing.T) {
 	})
 }
 
+func TestAccNetworkingV2RouterInterface_forceDestroy(t *testing.T) {
+	var subnet subnets.Subnet
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2RouterInterfaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2RouterInterfaceForceDestroy,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2SubnetExists("openstack_networking_subnet_v2.subnet_1", &subnet),
+					testAccCheckNetworkingV2RouterInterfaceExists("openstack_networking_router_interface_v2.int_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_router_interface_v2.int_1", "force_destroy", "true"),
+					testAccCheckNetworkingV2RouterInterfaceCreateLingeringPort(&subnet),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckNetworkingV2RouterInterfaceDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	networkingClient, err := config.NetworkingV2Client(osRegionName)
@@ -144,6 +169,35 @@ func testAccCheckNetworkingV2RouterInterfaceExists(n string) resource.TestCheckF
 	}
 }
 
+// testAccCheckNetworkingV2RouterInterfaceCreateLingeringPort creates a port
+// outside of Terraform, which looks like a leftover of a deleted load balancer
+// and has to be removed by force_destroy.
+func testAccCheckNetworkingV2RouterInterfaceCreateLingeringPort(subnet *subnets.Subnet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.NetworkingV2Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+		}
+
+		createOpts := ports.CreateOpts{
+			Name:        "lingering_port",
+			NetworkID:   subnet.NetworkID,
+			DeviceOwner: "Octavia",
+			FixedIPs: []ports.IP{
+				{SubnetID: subnet.ID},
+			},
+		}
+
+		_, err = ports.Create(networkingClient, createOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error creating lingering port: %s", err)
+		}
+
+		return nil
+	}
+}
+
 const testAccNetworkingV2RouterInterfaceBasicSubnet = `
 resource "openstack_networking_router_v2" "router_1" {
   name = "router_1"
@@ -228,3 +282,27 @@ resource "openstack_networking_subnet_v2" "subnet_1" {
   network_id = "${openstack_networking_network_v2.network_1.id}"
 }
 `
+
+const testAccNetworkingV2RouterInterfaceForceDestroy = `
+resource "openstack_networking_router_v2" "router_1" {
+  name = "router_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_router_interface_v2" "int_1" {
+  subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+  router_id = "${openstack_networking_router_v2.router_1.id}"
+  force_destroy = true
+}
+
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+}
+`
@@ -65,34 +65,20 @@ func resourceNetworkingRouterRouteV2Create(d *schema.ResourceData, meta interfac
 
 	log.Printf("[DEBUG] Retrieved openstack_networking_router_v2 %s: %#v", routerID, r)
 
-	routes := r.Routes
 	dstCIDR := d.Get("destination_cidr").(string)
 	nextHop := d.Get("next_hop").(string)
-	exists := false
-
-	for _, route := range routes {
-		if route.DestinationCIDR == dstCIDR && route.NextHop == nextHop {
-			exists = true
-			break
-		}
+	route := routers.Route{
+		DestinationCIDR: dstCIDR,
+		NextHop:         nextHop,
 	}
 
-	if exists {
+	if networkingRouterV2RoutesContain(r.Routes, route) {
 		log.Printf("[DEBUG] openstack_networking_router_v2 %s already has route to %s via %s", routerID, dstCIDR, nextHop)
 		return resourceNetworkingRouterRouteV2Read(d, meta)
 	}
 
-	routes = append(routes, routers.Route{
-		DestinationCIDR: dstCIDR,
-		NextHop:         nextHop,
-	})
-	updateOpts := routers.UpdateOpts{
-		Routes: &routes,
-	}
-	log.Printf("[DEBUG] openstack_networking_router_v2 %s update options: %#v", routerID, updateOpts)
-	_, err = routers.Update(networkingClient, routerID, updateOpts).Extract()
-	if err != nil {
-		return fmt.Errorf("Error updating openstack_networking_router_v2: %s", err)
+	if err := networkingRouterV2AddRoutes(config, networkingClient, routerID, []routers.Route{route}); err != nil {
+		return err
 	}
 
 	d.SetId(resourceNetworkingRouterRouteV2BuildID(routerID, dstCIDR, nextHop))
@@ -158,27 +144,18 @@ func resourceNetworkingRouterRouteV2Delete(d *schema.ResourceData, meta interfac
 
 	dstCIDR := d.Get("destination_cidr").(string)
 	nextHop := d.Get("next_hop").(string)
-
-	oldRoutes := r.Routes
-	newRoute := []routers.Route{}
-
-	for _, route := range oldRoutes {
-		if route.DestinationCIDR != dstCIDR || route.NextHop != nextHop {
-			newRoute = append(newRoute, route)
-		}
+	route := routers.Route{
+		DestinationCIDR: dstCIDR,
+		NextHop:         nextHop,
 	}
 
-	if len(oldRoutes) == len(newRoute) {
+	if !networkingRouterV2RoutesContain(r.Routes, route) {
 		return fmt.Errorf("Can't find route to %s via %s on openstack_networking_router_v2 %s", dstCIDR, nextHop, routerID)
 	}
 
 	log.Printf("[DEBUG] Deleting openstack_networking_router_v2 %s route to %s via %s", routerID, dstCIDR, nextHop)
-	updateOpts := routers.UpdateOpts{
-		Routes: &newRoute,
-	}
-	_, err = routers.Update(networkingClient, routerID, updateOpts).Extract()
-	if err != nil {
-		return fmt.Errorf("Error updating openstack_networking_router_v2: %s", err)
+	if err := networkingRouterV2RemoveRoutes(config, networkingClient, routerID, []routers.Route{route}); err != nil {
+		return err
 	}
 
 	return nil
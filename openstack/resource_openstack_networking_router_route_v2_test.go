@@ -54,6 +54,30 @@ func TestAccNetworkingV2RouterRoute_basic(t *testing.T) {
 	})
 }
 
+func TestAccNetworkingV2RouterRoute_concurrent(t *testing.T) {
+	var router routers.Router
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				// Both routes are created in parallel on the same router.
+				Config: testAccNetworkingV2RouterRouteUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2RouterExists("openstack_networking_router_v2.router_1", &router),
+					testAccCheckNetworkingV2RouterRouteExists("openstack_networking_router_route_v2.router_route_1"),
+					testAccCheckNetworkingV2RouterRouteExists("openstack_networking_router_route_v2.router_route_2"),
+					testAccCheckNetworkingV2RouterRoutesCount(&router, 2),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckNetworkingV2RouterRouteEmpty(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
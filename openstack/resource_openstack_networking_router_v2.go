@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/attributestags"
@@ -37,6 +38,7 @@ func resourceNetworkingRouterV2() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
@@ -70,7 +72,12 @@ func resourceNetworkingRouterV2() *schema.Resource {
 			"distributed": {
 				Type:     schema.TypeBool,
 				Optional: true,
-				ForceNew: true,
+				Computed: true,
+			},
+
+			"ha": {
+				Type:     schema.TypeBool,
+				Optional: true,
 				Computed: true,
 			},
 
@@ -162,6 +169,27 @@ func resourceNetworkingRouterV2() *schema.Resource {
 				},
 			},
 
+			"routes": {
+				Type:       schema.TypeSet,
+				Optional:   true,
+				Computed:   true,
+				ConfigMode: schema.SchemaConfigModeAttr,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination_cidr": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsCIDR,
+						},
+						"next_hop": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsIPAddress,
+						},
+					},
+				},
+			},
+
 			"tags": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -185,13 +213,13 @@ func resourceNetworkingRouterV2Create(d *schema.ResourceData, meta interface{})
 	}
 
 	createOpts := RouterCreateOpts{
-		routers.CreateOpts{
+		CreateOpts: routers.CreateOpts{
 			Name:                  d.Get("name").(string),
 			Description:           d.Get("description").(string),
 			TenantID:              d.Get("tenant_id").(string),
 			AvailabilityZoneHints: resourceNetworkingAvailabilityZoneHintsV2(d),
 		},
-		MapValueSpecs(d),
+		ValueSpecs: MapValueSpecs(d),
 	}
 
 	if asuRaw, ok := d.GetOk("admin_state_up"); ok {
@@ -204,6 +232,11 @@ func resourceNetworkingRouterV2Create(d *schema.ResourceData, meta interface{})
 		createOpts.Distributed = &d
 	}
 
+	if haRaw, ok := d.GetOkExists("ha"); ok {
+		ha := haRaw.(bool)
+		createOpts.HA = &ha
+	}
+
 	// Get Vendor_options
 	vendorOptionsRaw := d.Get("vendor_options").(*schema.Set)
 	var vendorUpdateGateway bool
@@ -319,6 +352,22 @@ func resourceNetworkingRouterV2Create(d *schema.ResourceData, meta interface{})
 		}
 	}
 
+	if externalNetworkID != "" {
+		if err := networkingRouterV2WaitForGateway(networkingClient, r.ID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
+	routes := expandNetworkingRouterRoutesV2(d.Get("routes").(*schema.Set).List())
+	if len(routes) > 0 {
+		config.MutexKV.Lock(r.ID)
+		err := networkingRouterV2AddRoutes(config, networkingClient, r.ID, routes)
+		config.MutexKV.Unlock(r.ID)
+		if err != nil {
+			return err
+		}
+	}
+
 	tags := networkingV2AttributesTags(d)
 	if len(tags) > 0 {
 		tagOpts := attributestags.ReplaceAllOpts{Tags: tags}
@@ -340,7 +389,8 @@ func resourceNetworkingRouterV2Read(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
-	r, err := routers.Get(networkingClient, d.Id()).Extract()
+	var r routerExtended
+	err = routers.Get(networkingClient, d.Id()).ExtractIntoStructPtr(&r, "router")
 	if err != nil {
 		if _, ok := err.(gophercloud.ErrDefault404); ok {
 			d.SetId("")
@@ -356,10 +406,17 @@ func resourceNetworkingRouterV2Read(d *schema.ResourceData, meta interface{}) er
 	d.Set("name", r.Name)
 	d.Set("description", r.Description)
 	d.Set("admin_state_up", r.AdminStateUp)
-	d.Set("distributed", r.Distributed)
 	d.Set("tenant_id", r.TenantID)
 	d.Set("region", GetRegion(d, config))
 
+	// Only set the extension attributes, which are returned.
+	if r.Distributed != nil {
+		d.Set("distributed", *r.Distributed)
+	}
+	if r.HA != nil {
+		d.Set("ha", *r.HA)
+	}
+
 	networkingV2ReadAttributesTags(d, r.Tags)
 
 	if err := d.Set("availability_zone_hints", r.AvailabilityZoneHints); err != nil {
@@ -376,6 +433,10 @@ func resourceNetworkingRouterV2Read(d *schema.ResourceData, meta interface{}) er
 		log.Printf("[DEBUG] Unable to set openstack_networking_router_v2 %s external_fixed_ip: %s", d.Id(), err)
 	}
 
+	if err = d.Set("routes", flattenNetworkingRouterRoutesV2(r.Routes)); err != nil {
+		log.Printf("[DEBUG] Unable to set openstack_networking_router_v2 %s routes: %s", d.Id(), err)
+	}
+
 	return nil
 }
 
@@ -390,6 +451,26 @@ func resourceNetworkingRouterV2Update(d *schema.ResourceData, meta interface{})
 	config.MutexKV.Lock(routerID)
 	defer config.MutexKV.Unlock(routerID)
 
+	// Changing the mode of a router requires to take it down, which
+	// also applies the admin_state_up change.
+	modeChange := d.HasChange("distributed") || d.HasChange("ha")
+	if modeChange {
+		var distributed, ha *bool
+		if d.HasChange("distributed") {
+			v := d.Get("distributed").(bool)
+			distributed = &v
+		}
+		if d.HasChange("ha") {
+			v := d.Get("ha").(bool)
+			ha = &v
+		}
+
+		err := networkingRouterV2UpdateMode(networkingClient, d.Id(), distributed, ha, d.Get("admin_state_up").(bool))
+		if err != nil {
+			return err
+		}
+	}
+
 	var hasChange bool
 	var updateOpts routers.UpdateOpts
 	if d.HasChange("name") {
@@ -401,7 +482,7 @@ func resourceNetworkingRouterV2Update(d *schema.ResourceData, meta interface{})
 		description := d.Get("description").(string)
 		updateOpts.Description = &description
 	}
-	if d.HasChange("admin_state_up") {
+	if d.HasChange("admin_state_up") && !modeChange {
 		hasChange = true
 		asu := d.Get("admin_state_up").(bool)
 		updateOpts.AdminStateUp = &asu
@@ -440,6 +521,12 @@ func resourceNetworkingRouterV2Update(d *schema.ResourceData, meta interface{})
 
 		enableSNAT := d.Get("enable_snat").(bool)
 		gatewayInfo.EnableSNAT = &enableSNAT
+
+		// Keep the gateway addresses when only SNAT is toggled, so that
+		// the router doesn't get a new external IP.
+		if !d.HasChange("external_gateway") && !d.HasChange("external_network_id") && !d.HasChange("external_fixed_ip") {
+			gatewayInfo.ExternalFixedIPs = expandNetworkingRouterExternalFixedIPsV2(d.Get("external_fixed_ip").([]interface{}))
+		}
 	}
 
 	if d.HasChange("external_fixed_ip") {
@@ -467,6 +554,33 @@ func resourceNetworkingRouterV2Update(d *schema.ResourceData, meta interface{})
 		}
 	}
 
+	if updateGatewaySettings && externalNetworkID != "" {
+		if err := networkingRouterV2WaitForGateway(networkingClient, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("routes") {
+		o, n := d.GetChange("routes")
+		oldRoutes, newRoutes := o.(*schema.Set), n.(*schema.Set)
+
+		// Remove the stale routes first, so a route with a changed
+		// next_hop doesn't conflict with its old value.
+		removedRoutes := expandNetworkingRouterRoutesV2(oldRoutes.Difference(newRoutes).List())
+		if len(removedRoutes) > 0 {
+			if err := networkingRouterV2RemoveRoutes(config, networkingClient, d.Id(), removedRoutes); err != nil {
+				return err
+			}
+		}
+
+		addedRoutes := expandNetworkingRouterRoutesV2(newRoutes.Difference(oldRoutes).List())
+		if len(addedRoutes) > 0 {
+			if err := networkingRouterV2AddRoutes(config, networkingClient, d.Id(), addedRoutes); err != nil {
+				return err
+			}
+		}
+	}
+
 	// Next, perform any required updates to the tags.
 	if d.HasChange("tags") {
 		tags := networkingV2UpdateAttributesTags(d)
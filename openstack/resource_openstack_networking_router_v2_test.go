@@ -64,6 +64,84 @@ func TestAccNetworkingV2Router_updateExternalGateway(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(
 						"openstack_networking_router_v2.router_1", "external_network_id", osExtGwID),
+					resource.TestCheckResourceAttrSet(
+						"openstack_networking_router_v2.router_1", "external_fixed_ip.0.ip_address"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_networking_router_v2.router_1", "external_fixed_ip.0.subnet_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkingV2Router_updateEnableSNAT(t *testing.T) {
+	var router routers.Router
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2RouterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2RouterEnableSNAT(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2RouterExists("openstack_networking_router_v2.router_1", &router),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_router_v2.router_1", "enable_snat", "true"),
+				),
+			},
+			{
+				Config: testAccNetworkingV2RouterEnableSNAT(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2RouterGatewayIPUnchanged("openstack_networking_router_v2.router_1", &router),
+					testAccCheckNetworkingV2RouterExists("openstack_networking_router_v2.router_1", &router),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_router_v2.router_1", "enable_snat", "false"),
+				),
+			},
+			{
+				Config: testAccNetworkingV2RouterEnableSNAT(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2RouterExists("openstack_networking_router_v2.router_1", &router),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_router_v2.router_1", "enable_snat", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkingV2Router_updateDistributed(t *testing.T) {
+	var router routers.Router
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckDVR(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2RouterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2RouterDistributed(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2RouterExists("openstack_networking_router_v2.router_1", &router),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_router_v2.router_1", "distributed", "false"),
+				),
+			},
+			{
+				Config: testAccNetworkingV2RouterDistributed(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2RouterExists("openstack_networking_router_v2.router_1", &router),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_router_v2.router_1", "distributed", "true"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_router_v2.router_1", "admin_state_up", "true"),
 				),
 			},
 		},
@@ -87,6 +165,8 @@ func TestAccNetworkingV2Router_vendor_opts(t *testing.T) {
 					testAccCheckNetworkingV2RouterExists("openstack_networking_router_v2.router_1", &router),
 					resource.TestCheckResourceAttr(
 						"openstack_networking_router_v2.router_1", "external_gateway", osExtGwID),
+					resource.TestCheckResourceAttrSet(
+						"openstack_networking_router_v2.router_1", "external_fixed_ip.0.ip_address"),
 				),
 			},
 		},
@@ -167,6 +247,66 @@ func TestAccNetworkingV2Router_extSubnetIDs(t *testing.T) {
 	})
 }
 
+func TestAccNetworkingV2Router_routes(t *testing.T) {
+	var router routers.Router
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2RouterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2RouterRoutes(""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2RouterExists("openstack_networking_router_v2.router_1", &router),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_router_v2.router_1", "routes.#", "0"),
+				),
+			},
+			{
+				Config: testAccNetworkingV2RouterRoutes(testAccNetworkingV2RouterRoutesThree),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2RouterExists("openstack_networking_router_v2.router_1", &router),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_router_v2.router_1", "routes.#", "3"),
+					testAccCheckNetworkingV2RouterRoutesCount(&router, 3),
+				),
+			},
+			{
+				Config: testAccNetworkingV2RouterRoutes(testAccNetworkingV2RouterRoutesOne),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2RouterExists("openstack_networking_router_v2.router_1", &router),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_router_v2.router_1", "routes.#", "1"),
+					testAccCheckNetworkingV2RouterRoutesCount(&router, 1),
+				),
+			},
+			{
+				Config: testAccNetworkingV2RouterRoutes(testAccNetworkingV2RouterRoutesEmpty),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2RouterExists("openstack_networking_router_v2.router_1", &router),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_router_v2.router_1", "routes.#", "0"),
+					testAccCheckNetworkingV2RouterRoutesCount(&router, 0),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingV2RouterRoutesCount(router *routers.Router, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if len(router.Routes) != expected {
+			return fmt.Errorf("Expected %d routes on router %s, got %d", expected, router.ID, len(router.Routes))
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckNetworkingV2RouterDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	networkingClient, err := config.NetworkingV2Client(osRegionName)
@@ -220,6 +360,26 @@ func testAccCheckNetworkingV2RouterExists(n string, router *routers.Router) reso
 	}
 }
 
+func testAccCheckNetworkingV2RouterGatewayIPUnchanged(n string, router *routers.Router) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if len(router.GatewayInfo.ExternalFixedIPs) == 0 {
+			return fmt.Errorf("Router %s has no external fixed IPs", router.ID)
+		}
+
+		old := router.GatewayInfo.ExternalFixedIPs[0].IPAddress
+		if v := rs.Primary.Attributes["external_fixed_ip.0.ip_address"]; v != old {
+			return fmt.Errorf("Router gateway IP changed from %s to %s", old, v)
+		}
+
+		return nil
+	}
+}
+
 const testAccNetworkingV2RouterBasic = `
 resource "openstack_networking_router_v2" "router_1" {
   name = "router_1"
@@ -290,6 +450,27 @@ resource "openstack_networking_router_v2" "router_1" {
 `, osExtGwID)
 }
 
+func testAccNetworkingV2RouterEnableSNAT(enableSNAT bool) string {
+	return fmt.Sprintf(`
+resource "openstack_networking_router_v2" "router_1" {
+  name = "router_1"
+  admin_state_up = "true"
+  external_network_id = "%s"
+  enable_snat = "%t"
+}
+`, osExtGwID, enableSNAT)
+}
+
+func testAccNetworkingV2RouterDistributed(distributed bool) string {
+	return fmt.Sprintf(`
+resource "openstack_networking_router_v2" "router_1" {
+  name = "router_1"
+  admin_state_up = "true"
+  distributed = "%t"
+}
+`, distributed)
+}
+
 func testAccNetworkingV2RouterExtFixedIPs() string {
 	return fmt.Sprintf(`
 resource "openstack_networking_router_v2" "router_1" {
@@ -355,3 +536,57 @@ resource "openstack_networking_router_v2" "router_2" {
 }
 `, osExtGwID, osExtGwID, osExtGwID, osExtGwID)
 }
+
+func testAccNetworkingV2RouterRoutes(routes string) string {
+	return fmt.Sprintf(`
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+}
+
+resource "openstack_networking_router_v2" "router_1" {
+  name = "router_1"
+  admin_state_up = "true"
+%s
+}
+
+resource "openstack_networking_router_interface_v2" "int_1" {
+  router_id = "${openstack_networking_router_v2.router_1.id}"
+  subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+}
+`, routes)
+}
+
+const testAccNetworkingV2RouterRoutesThree = `
+  routes {
+    destination_cidr = "10.0.1.0/24"
+    next_hop = "192.168.199.10"
+  }
+
+  routes {
+    destination_cidr = "10.0.2.0/24"
+    next_hop = "192.168.199.20"
+  }
+
+  routes {
+    destination_cidr = "10.0.3.0/24"
+    next_hop = "192.168.199.30"
+  }
+`
+
+const testAccNetworkingV2RouterRoutesOne = `
+  routes {
+    destination_cidr = "10.0.2.0/24"
+    next_hop = "192.168.199.20"
+  }
+`
+
+const testAccNetworkingV2RouterRoutesEmpty = `
+  routes = []
+`
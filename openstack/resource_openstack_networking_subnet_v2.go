@@ -28,6 +28,7 @@ func resourceNetworkingSubnetV2() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
@@ -425,6 +426,15 @@ func resourceNetworkingSubnetV2Update(d *schema.ResourceData, meta interface{})
 			hasChange = true
 			gatewayIP := ""
 			updateOpts.GatewayIP = &gatewayIP
+		} else if _, ok := d.GetOk("gateway_ip"); !ok {
+			// Neutron doesn't pick a default gateway on update,
+			// so restore the one it would have used on create.
+			gatewayIP, err := networkingSubnetV2DefaultGatewayIP(d.Get("cidr").(string))
+			if err != nil {
+				return fmt.Errorf("Error restoring gateway_ip for openstack_networking_subnet_v2 %s: %s", d.Id(), err)
+			}
+			hasChange = true
+			updateOpts.GatewayIP = &gatewayIP
 		}
 	}
 
@@ -449,12 +459,22 @@ func resourceNetworkingSubnetV2Update(d *schema.ResourceData, meta interface{})
 		updateOpts.EnableDHCP = &v
 	}
 
+	// Neutron only supports replacing the whole list of allocation pools,
+	// so send it only when the pools actually differ.
+	var oldPools, newPools interface{}
 	if d.HasChange("allocation_pool") {
-		hasChange = true
-		updateOpts.AllocationPools = expandNetworkingSubnetV2AllocationPools(d.Get("allocation_pool").(*schema.Set).List())
+		o, n := d.GetChange("allocation_pool")
+		oldPools, newPools = o.(*schema.Set).List(), n.(*schema.Set).List()
 	} else if d.HasChange("allocation_pools") {
-		hasChange = true
-		updateOpts.AllocationPools = expandNetworkingSubnetV2AllocationPools(d.Get("allocation_pools").([]interface{}))
+		oldPools, newPools = d.GetChange("allocation_pools")
+	}
+	if newPools != nil {
+		added, removed := networkingSubnetV2AllocationPoolsDelta(oldPools.([]interface{}), newPools.([]interface{}))
+		if len(added) > 0 || len(removed) > 0 {
+			log.Printf("[DEBUG] openstack_networking_subnet_v2 %s allocation pools to add: %#v, to remove: %#v", d.Id(), added, removed)
+			hasChange = true
+			updateOpts.AllocationPools = expandNetworkingSubnetV2AllocationPools(newPools.([]interface{}))
+		}
 	}
 
 	if hasChange {
@@ -465,6 +485,27 @@ func resourceNetworkingSubnetV2Update(d *schema.ResourceData, meta interface{})
 		}
 	}
 
+	// The DHCP agent releases its ports asynchronously. Wait for it, so
+	// a later enable_dhcp flip doesn't race with a stale DHCP port.
+	// Newly created DHCP ports aren't awaited, since some backends
+	// (e.g. OVN) serve DHCP without creating any port at all.
+	if d.HasChange("enable_dhcp") && !d.Get("enable_dhcp").(bool) {
+		log.Printf("[DEBUG] Waiting for DHCP ports of openstack_networking_subnet_v2 %s to be released", d.Id())
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"PENDING"},
+			Target:     []string{"RELEASED"},
+			Refresh:    networkingSubnetV2DHCPPortsStateRefreshFunc(networkingClient, d.Id()),
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
+			Delay:      0,
+			MinTimeout: 3 * time.Second,
+		}
+
+		_, err = stateConf.WaitForState()
+		if err != nil {
+			return fmt.Errorf("Error waiting for DHCP ports of openstack_networking_subnet_v2 %s to be released: %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("tags") {
 		tags := networkingV2UpdateAttributesTags(d)
 		tagOpts := attributestags.ReplaceAllOpts{Tags: tags}
@@ -97,6 +97,46 @@ func TestAccNetworkingV2Subnet_disableDHCP(t *testing.T) {
 	})
 }
 
+func TestAccNetworkingV2Subnet_toggleDHCP(t *testing.T) {
+	var subnet subnets.Subnet
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2SubnetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2SubnetEnableDhcp,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2SubnetExists("openstack_networking_subnet_v2.subnet_1", &subnet),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_subnet_v2.subnet_1", "enable_dhcp", "true"),
+				),
+			},
+			{
+				Config: testAccNetworkingV2SubnetDisableDhcp,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2SubnetExists("openstack_networking_subnet_v2.subnet_1", &subnet),
+					testAccCheckNetworkingV2SubnetNoDHCPPorts(&subnet),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_subnet_v2.subnet_1", "enable_dhcp", "false"),
+				),
+			},
+			{
+				Config: testAccNetworkingV2SubnetEnableDhcp,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2SubnetExists("openstack_networking_subnet_v2.subnet_1", &subnet),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_subnet_v2.subnet_1", "enable_dhcp", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccNetworkingV2Subnet_noGateway(t *testing.T) {
 	var subnet subnets.Subnet
 
@@ -120,6 +160,51 @@ func TestAccNetworkingV2Subnet_noGateway(t *testing.T) {
 	})
 }
 
+func TestAccNetworkingV2Subnet_toggleGateway(t *testing.T) {
+	var subnet subnets.Subnet
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2SubnetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2SubnetImpliedGateway,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2SubnetExists("openstack_networking_subnet_v2.subnet_1", &subnet),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_subnet_v2.subnet_1", "gateway_ip", "192.168.199.1"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_subnet_v2.subnet_1", "no_gateway", "false"),
+				),
+			},
+			{
+				Config: testAccNetworkingV2SubnetNoGateway,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2SubnetExists("openstack_networking_subnet_v2.subnet_1", &subnet),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_subnet_v2.subnet_1", "gateway_ip", ""),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_subnet_v2.subnet_1", "no_gateway", "true"),
+				),
+			},
+			{
+				Config: testAccNetworkingV2SubnetImpliedGateway,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2SubnetExists("openstack_networking_subnet_v2.subnet_1", &subnet),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_subnet_v2.subnet_1", "gateway_ip", "192.168.199.1"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_subnet_v2.subnet_1", "no_gateway", "false"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccNetworkingV2Subnet_impliedGateway(t *testing.T) {
 	var subnet subnets.Subnet
 
@@ -305,6 +390,30 @@ func TestAccNetworkingV2Subnet_allocationPool(t *testing.T) {
 	})
 }
 
+func TestAccNetworkingV2Subnet_allocationPoolReordered(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2SubnetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingV2SubnetAllocationPool1,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_networking_subnet_v2.subnet_1", "allocation_pool.#", "2"),
+				),
+			},
+			{
+				Config:   testAccNetworkingV2SubnetAllocationPool1Reordered,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccNetworkingV2Subnet_clearDNSNameservers(t *testing.T) {
 	var subnet subnets.Subnet
 
@@ -410,6 +519,27 @@ func testAccCheckNetworkingV2SubnetDNSConsistency(n string, subnet *subnets.Subn
 	}
 }
 
+func testAccCheckNetworkingV2SubnetNoDHCPPorts(subnet *subnets.Subnet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.NetworkingV2Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+		}
+
+		_, state, err := networkingSubnetV2DHCPPortsStateRefreshFunc(networkingClient, subnet.ID)()
+		if err != nil {
+			return err
+		}
+
+		if state != "RELEASED" {
+			return fmt.Errorf("Subnet %s still has DHCP ports", subnet.ID)
+		}
+
+		return nil
+	}
+}
+
 const testAccNetworkingV2SubnetBasic = `
 resource "openstack_networking_network_v2" "network_1" {
   name = "network_1"
@@ -687,6 +817,29 @@ resource "openstack_networking_subnet_v2" "subnet_1" {
 }
 `
 
+const testAccNetworkingV2SubnetAllocationPool1Reordered = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "10.3.0.0/16"
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+
+  allocation_pool {
+    start = "10.3.255.0"
+    end = "10.3.255.254"
+  }
+
+  allocation_pool {
+    start = "10.3.0.2"
+    end = "10.3.0.255"
+  }
+}
+`
+
 const testAccNetworkingV2SubnetAllocationPool2 = `
 resource "openstack_networking_network_v2" "network_1" {
   name = "network_1"
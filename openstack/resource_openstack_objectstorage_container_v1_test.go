@@ -2,9 +2,11 @@ package openstack
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/containers"
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/objects"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 )
@@ -43,6 +45,58 @@ func TestAccObjectStorageV1Container_basic(t *testing.T) {
 	})
 }
 
+func TestAccObjectStorageV1Container_forceDestroy(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckSwift(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckObjectStorageV1ContainerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectStorageV1ContainerForceDestroy,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "force_destroy", "true"),
+					testAccCheckObjectStorageV1ContainerUploadObjects(
+						"openstack_objectstorage_container_v1.container_1", 5),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckObjectStorageV1ContainerUploadObjects uploads objects outside of
+// Terraform, so that the container is not empty when it is destroyed.
+func testAccCheckObjectStorageV1ContainerUploadObjects(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		objectStorageClient, err := config.ObjectStorageV1Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack object storage client: %s", err)
+		}
+
+		for i := 0; i < count; i++ {
+			name := fmt.Sprintf("object_%d", i)
+			opts := &objects.CreateOpts{
+				Content: strings.NewReader(name),
+			}
+			if err := objects.Create(objectStorageClient, rs.Primary.ID, name, opts).Err; err != nil {
+				return fmt.Errorf("Error uploading %s to container %s: %s", name, rs.Primary.ID, err)
+			}
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckObjectStorageV1ContainerDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	objectStorageClient, err := config.ObjectStorageV1Client(osRegionName)
@@ -75,6 +129,13 @@ resource "openstack_objectstorage_container_v1" "container_1" {
 }
 `
 
+const testAccObjectStorageV1ContainerForceDestroy = `
+resource "openstack_objectstorage_container_v1" "container_1" {
+  name = "container_1"
+  force_destroy = true
+}
+`
+
 const testAccObjectStorageV1ContainerComplete = `
 resource "openstack_objectstorage_container_v1" "container_1" {
   name = "container_1"
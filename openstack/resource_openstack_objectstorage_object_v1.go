@@ -7,6 +7,7 @@ import (
 	"os"
 	"time"
 
+	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/objects"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/mitchellh/go-homedir"
@@ -63,13 +64,13 @@ func resourceObjectStorageObjectV1() *schema.Resource {
 			"content": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"source", "copy_from", "object_manifest"},
+				ConflictsWith: []string{"source", "copy_from", "object_manifest", "symlink_target"},
 			},
 
 			"copy_from": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"content", "source", "object_manifest"},
+				ConflictsWith: []string{"content", "source", "object_manifest", "symlink_target"},
 			},
 
 			"delete_after": {
@@ -107,13 +108,27 @@ func resourceObjectStorageObjectV1() *schema.Resource {
 				Type:          schema.TypeString,
 				Computed:      true,
 				Optional:      true,
-				ConflictsWith: []string{"copy_from", "source", "content"},
+				ConflictsWith: []string{"copy_from", "source", "content", "symlink_target"},
 			},
 
 			"source": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"content", "copy_from", "object_manifest"},
+				ConflictsWith: []string{"content", "copy_from", "object_manifest", "symlink_target"},
+			},
+
+			"symlink_target": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"content", "copy_from", "object_manifest", "source"},
+			},
+
+			"symlink_target_account": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"symlink_target"},
 			},
 
 			// Read Only
@@ -187,8 +202,14 @@ func resourceObjectStorageObjectV1Create(d *schema.ResourceData, meta interface{
 		createOpts.ObjectManifest = v.(string)
 	}
 
+	// a symlink is created with an empty body
+	if _, ok := d.GetOk("symlink_target"); ok {
+		isValid = true
+		createOpts.Content = bytes.NewReader([]byte(""))
+	}
+
 	if !isValid {
-		return fmt.Errorf("Must specify \"source\", \"content\", \"copy_from\" or \"object_manifest\" field")
+		return fmt.Errorf("Must specify \"source\", \"content\", \"copy_from\", \"object_manifest\" or \"symlink_target\" field")
 	}
 
 	if v, ok := d.GetOk("content_disposition"); ok {
@@ -224,8 +245,17 @@ func resourceObjectStorageObjectV1Create(d *schema.ResourceData, meta interface{
 		createOpts.ETag = v.(string)
 	}
 
-	log.Printf("[DEBUG] Create Options: %#v", createOpts)
-	_, err = objects.Create(objectStorageClient, cn, name, createOpts).Extract()
+	var createOptsBuilder objects.CreateOptsBuilder = createOpts
+	if v, ok := d.GetOk("symlink_target"); ok {
+		createOptsBuilder = ObjectSymlinkCreateOptsExt{
+			CreateOptsBuilder:    createOpts,
+			SymlinkTarget:        v.(string),
+			SymlinkTargetAccount: d.Get("symlink_target_account").(string),
+		}
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOptsBuilder)
+	_, err = objects.Create(objectStorageClient, cn, name, createOptsBuilder).Extract()
 	if err != nil {
 		return fmt.Errorf("Error creating OpenStack container object: %s", err)
 	}
@@ -256,13 +286,37 @@ func resourceObjectStorageObjectV1Read(d *schema.ResourceData, meta interface{})
 	}
 
 	log.Printf("[DEBUG] Get Options: %#v", getOpts)
-	result, err := objects.Get(objectStorageClient, cn, name, getOpts).Extract()
+	// Retrieve a symlink itself, which also works if its target is gone.
+	getResult := objects.Get(objectStorageClient, cn, name, ObjectSymlinkGetOptsExt{getOpts})
+	result, err := getResult.Extract()
 	if err != nil {
 		return fmt.Errorf("Error getting OpenStack container object: %s", err)
 	}
 
+	symlinkTarget, symlinkTargetAccount := objectStorageObjectV1SymlinkTarget(getResult.Header)
+
+	// A symlink is described by its target, as long as the target exists.
+	if symlinkTarget != "" {
+		targetResult := objects.Get(objectStorageClient, cn, name, getOpts)
+		targetHeader, err := targetResult.Extract()
+		if err == nil {
+			getResult, result = targetResult, targetHeader
+		} else if _, ok := err.(gophercloud.ErrDefault404); ok {
+			log.Printf("[DEBUG] The target %s of openstack_objectstorage_object_v1 %s doesn't exist", symlinkTarget, d.Id())
+		} else {
+			return fmt.Errorf("Error getting the target of OpenStack container object: %s", err)
+		}
+	}
+
 	log.Printf("[DEBUG] Retrieved OpenStack Object Storage Object: %#v", result)
 
+	metadata, err := getResult.ExtractMetadata()
+	if err != nil {
+		return fmt.Errorf("Error extracting OpenStack container object metadata: %s", err)
+	}
+
+	log.Printf("[DEBUG] Retrieved OpenStack Object Storage Object metadata: %#v", metadata)
+
 	d.Set("etag", result.ETag)
 	d.Set("content_disposition", result.ContentDisposition)
 	d.Set("content_encoding", result.ContentEncoding)
@@ -279,6 +333,13 @@ func resourceObjectStorageObjectV1Read(d *schema.ResourceData, meta interface{})
 	}
 	d.Set("object_manifest", result.ObjectManifest)
 	d.Set("trans_id", result.TransID)
+	d.Set("symlink_target", symlinkTarget)
+	d.Set("symlink_target_account", symlinkTargetAccount)
+
+	flatMetadata := flattenObjectStorageObjectV1Metadata(d.Get("metadata").(map[string]interface{}), metadata)
+	if err := d.Set("metadata", flatMetadata); err != nil {
+		log.Printf("[DEBUG] Unable to set metadata for openstack_objectstorage_object_v1 %s: %s", d.Id(), err)
+	}
 
 	return nil
 }
@@ -367,8 +428,18 @@ func resourceObjectStorageObjectV1Update(d *schema.ResourceData, meta interface{
 		createOpts.ETag = d.Get("etag").(string)
 	}
 
-	log.Printf("[DEBUG] Update Options: %#v", createOpts)
-	_, err = objects.Create(objectStorageClient, cn, name, createOpts).Extract()
+	// keep the object a symlink when it is uploaded again
+	var createOptsBuilder objects.CreateOptsBuilder = createOpts
+	if v, ok := d.GetOk("symlink_target"); ok {
+		createOptsBuilder = ObjectSymlinkCreateOptsExt{
+			CreateOptsBuilder:    createOpts,
+			SymlinkTarget:        v.(string),
+			SymlinkTargetAccount: d.Get("symlink_target_account").(string),
+		}
+	}
+
+	log.Printf("[DEBUG] Update Options: %#v", createOptsBuilder)
+	_, err = objects.Create(objectStorageClient, cn, name, createOptsBuilder).Extract()
 	if err != nil {
 		return fmt.Errorf("Error updating OpenStack container object: %s", err)
 	}
@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"log"
 	"os"
+	"regexp"
 	"strings"
 	"testing"
 	"time"
@@ -167,6 +168,106 @@ func TestAccObjectStorageV1Object_detectContentType(t *testing.T) {
 	})
 }
 
+func TestAccObjectStorageV1Object_metadata(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckSwift(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(s *terraform.State) error {
+			return testAccCheckObjectStorageV1ObjectDestroy(s, "terraform/test/myfile.txt")
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectStorageV1ObjectMetadata,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_object_v1.myfile", "content_type", "application/json"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_object_v1.myfile", "content_encoding", "gzip"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_object_v1.myfile", "metadata.%", "2"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_object_v1.myfile", "metadata.foo", "bar"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_object_v1.myfile", "metadata.build-id", "42"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccObjectStorageV1Object_etag(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckSwift(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(s *terraform.State) error {
+			return testAccCheckObjectStorageV1ObjectDestroy(s, "terraform/test/myfile.txt")
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccObjectStorageV1ObjectEtag(barMD5()),
+				ExpectError: regexp.MustCompile("Error creating OpenStack container object"),
+			},
+			{
+				Config: testAccObjectStorageV1ObjectEtag(fooMD5()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_object_v1.myfile", "etag", fooMD5()),
+				),
+			},
+		},
+	})
+}
+
+func TestAccObjectStorageV1Object_symlink(t *testing.T) {
+	var object objects.GetHeader
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckSwift(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(s *terraform.State) error {
+			return testAccCheckObjectStorageV1ObjectDestroy(s, "terraform/test/mylink.txt")
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectStorageV1ObjectSymlink,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectStorageV1ObjectExists(
+						"openstack_objectstorage_object_v1.mylink", &object),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_object_v1.mylink", "symlink_target", "tf_test_container_1/terraform/test/myfile.txt"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_object_v1.mylink", "etag", fooMD5()),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_object_v1.mylink", "content_length", "3"),
+				),
+			},
+			{
+				Config: testAccObjectStorageV1ObjectSymlinkDangling,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectStorageV1ObjectExists(
+						"openstack_objectstorage_object_v1.mylink", &object),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_object_v1.mylink", "symlink_target", "tf_test_container_1/terraform/test/myfile.txt"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_object_v1.mylink", "content_length", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccObjectStorageV1Object_copyFrom(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -274,7 +375,7 @@ func testAccCheckObjectStorageV1ObjectExists(n string, object *objects.GetHeader
 			return fmt.Errorf("Malformed object name: %s", rs.Primary.ID)
 		}
 
-		found, err := objects.Get(objectStorageClient, parts[0], parts[1], nil).Extract()
+		found, err := objects.Get(objectStorageClient, parts[0], parts[1], ObjectSymlinkGetOptsExt{objects.GetOpts{}}).Extract()
 		if err != nil {
 			return err
 		}
@@ -401,6 +502,70 @@ resource "openstack_objectstorage_object_v1" "myfile" {
 }
 `
 
+const testAccObjectStorageV1ObjectMetadata = `
+resource "openstack_objectstorage_container_v1" "container_1" {
+  name = "tf_test_container_1"
+}
+
+resource "openstack_objectstorage_object_v1" "myfile" {
+  name = "terraform/test/myfile.txt"
+  container_name = "${openstack_objectstorage_container_v1.container_1.name}"
+  content = "{}"
+  content_type = "application/json"
+  content_encoding = "gzip"
+
+  metadata = {
+    foo = "bar"
+    build-id = "42"
+  }
+}
+`
+
+func testAccObjectStorageV1ObjectEtag(etag string) string {
+	return fmt.Sprintf(`
+resource "openstack_objectstorage_container_v1" "container_1" {
+  name = "tf_test_container_1"
+}
+
+resource "openstack_objectstorage_object_v1" "myfile" {
+  name = "terraform/test/myfile.txt"
+  container_name = "${openstack_objectstorage_container_v1.container_1.name}"
+  content = "foo"
+  etag = "%s"
+}
+`, etag)
+}
+
+const testAccObjectStorageV1ObjectSymlink = `
+resource "openstack_objectstorage_container_v1" "container_1" {
+  name = "tf_test_container_1"
+}
+
+resource "openstack_objectstorage_object_v1" "myfile" {
+  name = "terraform/test/myfile.txt"
+  container_name = "${openstack_objectstorage_container_v1.container_1.name}"
+  content = "foo"
+}
+
+resource "openstack_objectstorage_object_v1" "mylink" {
+  name = "terraform/test/mylink.txt"
+  container_name = "${openstack_objectstorage_container_v1.container_1.name}"
+  symlink_target = "${openstack_objectstorage_container_v1.container_1.name}/${openstack_objectstorage_object_v1.myfile.name}"
+}
+`
+
+const testAccObjectStorageV1ObjectSymlinkDangling = `
+resource "openstack_objectstorage_container_v1" "container_1" {
+  name = "tf_test_container_1"
+}
+
+resource "openstack_objectstorage_object_v1" "mylink" {
+  name = "terraform/test/mylink.txt"
+  container_name = "${openstack_objectstorage_container_v1.container_1.name}"
+  symlink_target = "tf_test_container_1/terraform/test/myfile.txt"
+}
+`
+
 const testAccObjectStorageV1ObjectCopyFrom = `
 resource "openstack_objectstorage_container_v1" "container_1" {
   name = "tf_test_container_1"
@@ -67,6 +67,28 @@ func resourceObjectstorageTempurlV1() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"allowed_ip": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateObjectstorageTempurlV1AllowedIP,
+			},
+
+			"digest": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "sha1",
+				ValidateFunc: validateObjectstorageTempurlV1Digest,
+			},
+
+			"key": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+
 			"regenerate": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -110,7 +132,16 @@ func resourceObjectstorageTempurlV1Create(d *schema.ResourceData, meta interface
 
 	log.Printf("[DEBUG] Create temporary url Options: %#v", turlOptions)
 
-	url, err := objects.CreateTempURL(objectStorageClient, containerName, objectName, turlOptions)
+	key := d.Get("key").(string)
+	allowedIP := d.Get("allowed_ip").(string)
+	digest := d.Get("digest").(string)
+
+	var url string
+	if key != "" || allowedIP != "" || digest != "sha1" {
+		url, err = objectstorageTempurlV1Create(objectStorageClient, containerName, objectName, turlOptions, key, allowedIP, digest)
+	} else {
+		url, err = objects.CreateTempURL(objectStorageClient, containerName, objectName, turlOptions)
+	}
 	if err != nil {
 		return fmt.Errorf("Unable to generate a temporary url for the object %s in container %s: %s",
 			objectName, containerName, err)
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -57,6 +58,93 @@ func TestAccOpenStackObjectStorageTempurlV1_basic(t *testing.T) {
 	})
 }
 
+func TestAccOpenStackObjectStorageTempurlV1_allowedIP(t *testing.T) {
+	objectName := "object"
+	containerName := "container"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckSwift(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpenStackObjectstorageTempurlV1ResourceAllowedIP(containerName, objectName, "0.0.0.0/0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectstorageTempurlV1ResourceID("openstack_objectstorage_tempurl_v1.tempurl_1"),
+					testAccCheckObjectstorageTempurlV1Get("openstack_objectstorage_tempurl_v1.tempurl_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_tempurl_v1.tempurl_1", "allowed_ip", "0.0.0.0/0"),
+					resource.TestMatchResourceAttr(
+						"openstack_objectstorage_tempurl_v1.tempurl_1", "url",
+						regexp.MustCompile(`temp_url_ip_range=0\.0\.0\.0/0`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOpenStackObjectStorageTempurlV1_digest(t *testing.T) {
+	objectName := "object"
+	containerName := "container"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckSwift(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpenStackObjectstorageTempurlV1ResourceDigest(containerName, objectName, "sha256"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectstorageTempurlV1ResourceID("openstack_objectstorage_tempurl_v1.tempurl_1"),
+					testAccCheckObjectstorageTempurlV1Get("openstack_objectstorage_tempurl_v1.tempurl_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_tempurl_v1.tempurl_1", "digest", "sha256"),
+					resource.TestMatchResourceAttr(
+						"openstack_objectstorage_tempurl_v1.tempurl_1", "url",
+						regexp.MustCompile(`temp_url_sig=[0-9a-f]{64}&`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOpenStackObjectStorageTempurlV1_key(t *testing.T) {
+	objectName := "object"
+	containerName := "container"
+	var url string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckSwift(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpenStackObjectstorageTempurlV1ResourceKey(containerName, objectName, "testkey"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectstorageTempurlV1Get("openstack_objectstorage_tempurl_v1.tempurl_1"),
+					testAccCheckObjectstorageTempurlV1URL("openstack_objectstorage_tempurl_v1.tempurl_1", &url),
+				),
+			},
+			{
+				Config: testAccOpenStackObjectstorageTempurlV1ResourceKey(containerName, objectName, "newtestkey"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectstorageTempurlV1Get("openstack_objectstorage_tempurl_v1.tempurl_1"),
+					testAccCheckObjectstorageTempurlV1Regenerated("openstack_objectstorage_tempurl_v1.tempurl_1", &url),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckObjectstorageTempurlV1ResourceID(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -106,6 +194,34 @@ func testAccCheckObjectstorageTempurlV1Get(n string) resource.TestCheckFunc {
 	}
 }
 
+func testAccCheckObjectstorageTempurlV1URL(n string, url *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find temp url resource: %s", n)
+		}
+
+		*url = rs.Primary.Attributes["url"]
+
+		return nil
+	}
+}
+
+func testAccCheckObjectstorageTempurlV1Regenerated(n string, url *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find temp url resource: %s", n)
+		}
+
+		if rs.Primary.Attributes["url"] == *url {
+			return fmt.Errorf("Temp URL wasn't regenerated: %s", *url)
+		}
+
+		return nil
+	}
+}
+
 /*func testAccCheckObjectstorageTempurlV1Expired(n string, ttl int) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		time.Sleep(time.Duration(ttl))
@@ -140,3 +256,79 @@ resource "openstack_objectstorage_tempurl_v1" "tempurl_1" {
 }
 `, container, object, method, ttl)
 }
+
+func testAccOpenStackObjectstorageTempurlV1ResourceAllowedIP(container, object, allowedIP string) string {
+	return fmt.Sprintf(`
+resource "openstack_objectstorage_container_v1" "container_1" {
+  name = "%s"
+  metadata = {
+    Temp-URL-Key = "testkey"
+  }
+}
+
+resource "openstack_objectstorage_object_v1" "object_1" {
+  container_name = "${openstack_objectstorage_container_v1.container_1.name}"
+  name           = "%s"
+  content        = "Hello, world!"
+}
+
+resource "openstack_objectstorage_tempurl_v1" "tempurl_1" {
+  object     = "${openstack_objectstorage_object_v1.object_1.name}"
+  container  = "${openstack_objectstorage_container_v1.container_1.name}"
+  ttl        = 60
+  allowed_ip = "%s"
+}
+`, container, object, allowedIP)
+}
+
+func testAccOpenStackObjectstorageTempurlV1ResourceDigest(container, object, digest string) string {
+	return fmt.Sprintf(`
+resource "openstack_objectstorage_container_v1" "container_1" {
+  name = "%s"
+  metadata = {
+    Temp-URL-Key = "testkey"
+  }
+}
+
+resource "openstack_objectstorage_object_v1" "object_1" {
+  container_name = "${openstack_objectstorage_container_v1.container_1.name}"
+  name           = "%s"
+  content        = "Hello, world!"
+}
+
+resource "openstack_objectstorage_tempurl_v1" "tempurl_1" {
+  object    = "${openstack_objectstorage_object_v1.object_1.name}"
+  container = "${openstack_objectstorage_container_v1.container_1.name}"
+  ttl       = 60
+  digest    = "%s"
+}
+`, container, object, digest)
+}
+
+func testAccOpenStackObjectstorageTempurlV1ResourceKey(container, object, key string) string {
+	return fmt.Sprintf(`
+locals {
+  temp_url_key = "%s"
+}
+
+resource "openstack_objectstorage_container_v1" "container_1" {
+  name = "%s"
+  metadata = {
+    Temp-URL-Key = "${local.temp_url_key}"
+  }
+}
+
+resource "openstack_objectstorage_object_v1" "object_1" {
+  container_name = "${openstack_objectstorage_container_v1.container_1.name}"
+  name           = "%s"
+  content        = "Hello, world!"
+}
+
+resource "openstack_objectstorage_tempurl_v1" "tempurl_1" {
+  object    = "${openstack_objectstorage_object_v1.object_1.name}"
+  container = "${openstack_objectstorage_container_v1.container_1.name}"
+  ttl       = 60
+  key       = "${local.temp_url_key}"
+}
+`, key, container, object)
+}
@@ -0,0 +1,214 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceSharedFilesystemQuotaV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSharedFilesystemQuotaV2Create,
+		Read:   resourceSharedFilesystemQuotaV2Read,
+		Update: resourceSharedFilesystemQuotaV2Update,
+		Delete: schema.RemoveFromState,
+		Importer: &schema.ResourceImporter{
+			State: importQuota("openstack_sharedfilesystem_quota_v2"),
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"shares": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"gigabytes": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"snapshots": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"snapshot_gigabytes": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"share_networks": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSharedFilesystemQuotaV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	sfsClient, err := config.SharedfilesystemV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2MinMicroversion
+
+	projectID := d.Get("project_id").(string)
+
+	// Only set the quotas which were specified, the others keep their
+	// current value.
+	var updateOpts sharedFilesystemQuotaV2UpdateOpts
+
+	if v, ok := d.GetOkExists("shares"); ok {
+		shares := v.(int)
+		updateOpts.Shares = &shares
+	}
+
+	if v, ok := d.GetOkExists("gigabytes"); ok {
+		gigabytes := v.(int)
+		updateOpts.Gigabytes = &gigabytes
+	}
+
+	if v, ok := d.GetOkExists("snapshots"); ok {
+		snapshots := v.(int)
+		updateOpts.Snapshots = &snapshots
+	}
+
+	if v, ok := d.GetOkExists("snapshot_gigabytes"); ok {
+		snapshotGigabytes := v.(int)
+		updateOpts.SnapshotGigabytes = &snapshotGigabytes
+	}
+
+	if v, ok := d.GetOkExists("share_networks"); ok {
+		shareNetworks := v.(int)
+		updateOpts.ShareNetworks = &shareNetworks
+	}
+
+	q, err := sharedFilesystemQuotaV2Update(sfsClient, projectID, updateOpts)
+	if err != nil {
+		return fmt.Errorf("Error creating openstack_sharedfilesystem_quota_v2: %s", err)
+	}
+
+	id := fmt.Sprintf("%s/%s", projectID, region)
+	d.SetId(id)
+
+	log.Printf("[DEBUG] Created openstack_sharedfilesystem_quota_v2 %#v", q)
+
+	return resourceSharedFilesystemQuotaV2Read(d, meta)
+}
+
+func resourceSharedFilesystemQuotaV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	sfsClient, err := config.SharedfilesystemV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2MinMicroversion
+
+	// Parse projectID from resource id that is <project_id>/<region>
+	projectID := strings.Split(d.Id(), "/")[0]
+
+	q, err := sharedFilesystemQuotaV2Get(sfsClient, projectID)
+	if err != nil {
+		return CheckDeleted(d, err, "Error retrieving openstack_sharedfilesystem_quota_v2")
+	}
+
+	log.Printf("[DEBUG] Retrieved openstack_sharedfilesystem_quota_v2 %s: %#v", d.Id(), q)
+
+	d.Set("project_id", projectID)
+	d.Set("region", region)
+	d.Set("shares", q.Shares)
+	d.Set("gigabytes", q.Gigabytes)
+	d.Set("snapshots", q.Snapshots)
+	d.Set("snapshot_gigabytes", q.SnapshotGigabytes)
+	d.Set("share_networks", q.ShareNetworks)
+
+	return nil
+}
+
+func resourceSharedFilesystemQuotaV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	sfsClient, err := config.SharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack sharedfilesystem client: %s", err)
+	}
+
+	sfsClient.Microversion = sharedFilesystemV2MinMicroversion
+
+	var (
+		hasChange  bool
+		updateOpts sharedFilesystemQuotaV2UpdateOpts
+	)
+
+	if d.HasChange("shares") {
+		hasChange = true
+		shares := d.Get("shares").(int)
+		updateOpts.Shares = &shares
+	}
+
+	if d.HasChange("gigabytes") {
+		hasChange = true
+		gigabytes := d.Get("gigabytes").(int)
+		updateOpts.Gigabytes = &gigabytes
+	}
+
+	if d.HasChange("snapshots") {
+		hasChange = true
+		snapshots := d.Get("snapshots").(int)
+		updateOpts.Snapshots = &snapshots
+	}
+
+	if d.HasChange("snapshot_gigabytes") {
+		hasChange = true
+		snapshotGigabytes := d.Get("snapshot_gigabytes").(int)
+		updateOpts.SnapshotGigabytes = &snapshotGigabytes
+	}
+
+	if d.HasChange("share_networks") {
+		hasChange = true
+		shareNetworks := d.Get("share_networks").(int)
+		updateOpts.ShareNetworks = &shareNetworks
+	}
+
+	if hasChange {
+		log.Printf("[DEBUG] openstack_sharedfilesystem_quota_v2 %s update options: %#v", d.Id(), updateOpts)
+		projectID := d.Get("project_id").(string)
+		_, err := sharedFilesystemQuotaV2Update(sfsClient, projectID, updateOpts)
+		if err != nil {
+			return fmt.Errorf("Error updating openstack_sharedfilesystem_quota_v2: %s", err)
+		}
+	}
+
+	return resourceSharedFilesystemQuotaV2Read(d, meta)
+}
@@ -0,0 +1,76 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/projects"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccSFSV2Quota_basic(t *testing.T) {
+	var project projects.Project
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckSFS(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIdentityV3ProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSFSV2QuotaBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3ProjectExists("openstack_identity_project_v3.project_1", &project),
+					resource.TestCheckResourceAttr(
+						"openstack_sharedfilesystem_quota_v2.quota_1", "shares", "5"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_sharedfilesystem_quota_v2.quota_1", "gigabytes"),
+				),
+			},
+			{
+				Config: testAccSFSV2QuotaUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3ProjectExists("openstack_identity_project_v3.project_1", &project),
+					resource.TestCheckResourceAttr(
+						"openstack_sharedfilesystem_quota_v2.quota_1", "shares", "10"),
+					resource.TestCheckResourceAttr(
+						"openstack_sharedfilesystem_quota_v2.quota_1", "gigabytes", "100"),
+					resource.TestCheckResourceAttr(
+						"openstack_sharedfilesystem_quota_v2.quota_1", "snapshots", "20"),
+					resource.TestCheckResourceAttr(
+						"openstack_sharedfilesystem_quota_v2.quota_1", "snapshot_gigabytes", "200"),
+					resource.TestCheckResourceAttr(
+						"openstack_sharedfilesystem_quota_v2.quota_1", "share_networks", "3"),
+				),
+			},
+		},
+	})
+}
+
+const testAccSFSV2QuotaBasic = `
+resource "openstack_identity_project_v3" "project_1" {
+  name = "project_1"
+}
+
+resource "openstack_sharedfilesystem_quota_v2" "quota_1" {
+  project_id = "${openstack_identity_project_v3.project_1.id}"
+  shares     = 5
+}
+`
+
+const testAccSFSV2QuotaUpdate = `
+resource "openstack_identity_project_v3" "project_1" {
+  name = "project_1"
+}
+
+resource "openstack_sharedfilesystem_quota_v2" "quota_1" {
+  project_id         = "${openstack_identity_project_v3.project_1.id}"
+  shares             = 10
+  gigabytes          = 100
+  snapshots          = 20
+  snapshot_gigabytes = 200
+  share_networks     = 3
+}
+`
@@ -331,7 +331,7 @@ func resourceSiteConnectionV2Update(d *schema.ResourceData, meta interface{}) er
 	}
 
 	if d.HasChange("peer_cidrs") {
-		opts.PeerCIDRs = d.Get("peer_cidrs").([]string)
+		opts.PeerCIDRs = expandToStringSlice(d.Get("peer_cidrs").([]interface{}))
 		hasChange = true
 	}
 
@@ -43,6 +43,40 @@ func TestAccSiteConnectionV2_basic(t *testing.T) {
 	})
 }
 
+func TestAccSiteConnectionV2_peerCIDRs(t *testing.T) {
+	var conn siteconnections.Connection
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckNonAdminOnly(t)
+			testAccPreCheckVPN(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSiteConnectionV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSiteConnectionV2PeerCIDRs(`["10.0.0.0/24"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSiteConnectionV2Exists(
+						"openstack_vpnaas_site_connection_v2.conn_1", &conn),
+					resource.TestCheckResourceAttr("openstack_vpnaas_site_connection_v2.conn_1", "peer_cidrs.#", "1"),
+					resource.TestCheckResourceAttr("openstack_vpnaas_site_connection_v2.conn_1", "peer_cidrs.0", "10.0.0.0/24"),
+				),
+			},
+			{
+				Config: testAccSiteConnectionV2PeerCIDRs(`["10.0.0.0/24", "10.0.1.0/24"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSiteConnectionV2Exists(
+						"openstack_vpnaas_site_connection_v2.conn_1", &conn),
+					resource.TestCheckResourceAttr("openstack_vpnaas_site_connection_v2.conn_1", "peer_cidrs.#", "2"),
+					resource.TestCheckResourceAttr("openstack_vpnaas_site_connection_v2.conn_1", "peer_cidrs.0", "10.0.0.0/24"),
+					resource.TestCheckResourceAttr("openstack_vpnaas_site_connection_v2.conn_1", "peer_cidrs.1", "10.0.1.0/24"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckSiteConnectionV2Destroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	networkingClient, err := config.NetworkingV2Client(osRegionName)
@@ -150,3 +184,52 @@ func testAccSiteConnectionV2Basic() string {
 	}
 	`, osExtGwID)
 }
+
+func testAccSiteConnectionV2PeerCIDRs(peerCIDRs string) string {
+	return fmt.Sprintf(`
+	resource "openstack_networking_network_v2" "network_1" {
+		name           = "tf_test_network"
+  		admin_state_up = "true"
+	}
+
+	resource "openstack_networking_subnet_v2" "subnet_1" {
+  		network_id = "${openstack_networking_network_v2.network_1.id}"
+  		cidr       = "192.168.199.0/24"
+  		ip_version = 4
+	}
+
+	resource "openstack_networking_router_v2" "router_1" {
+  		name             = "my_router"
+  		external_network_id = "%s"
+	}
+
+	resource "openstack_networking_router_interface_v2" "router_interface_1" {
+  		router_id = "${openstack_networking_router_v2.router_1.id}"
+  		subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+	}
+
+	resource "openstack_vpnaas_service_v2" "service_1" {
+		router_id = "${openstack_networking_router_v2.router_1.id}"
+		subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+		admin_state_up = "false"
+		depends_on = ["openstack_networking_router_interface_v2.router_interface_1"]
+	}
+
+	resource "openstack_vpnaas_ipsec_policy_v2" "policy_1" {
+	}
+
+	resource "openstack_vpnaas_ike_policy_v2" "policy_2" {
+	}
+
+	resource "openstack_vpnaas_site_connection_v2" "conn_1" {
+		name = "connection_1"
+		ikepolicy_id = "${openstack_vpnaas_ike_policy_v2.policy_2.id}"
+		ipsecpolicy_id = "${openstack_vpnaas_ipsec_policy_v2.policy_1.id}"
+		vpnservice_id = "${openstack_vpnaas_service_v2.service_1.id}"
+		psk = "secret"
+		peer_address = "192.168.10.1"
+		peer_id = "192.168.10.1"
+		peer_cidrs = %s
+	}
+	`, osExtGwID, peerCIDRs)
+}
@@ -0,0 +1,58 @@
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// sharedFilesystemQuotaV2 represents the Manila quotas of a project.
+type sharedFilesystemQuotaV2 struct {
+	Shares            int `json:"shares"`
+	Gigabytes         int `json:"gigabytes"`
+	Snapshots         int `json:"snapshots"`
+	SnapshotGigabytes int `json:"snapshot_gigabytes"`
+	ShareNetworks     int `json:"share_networks"`
+}
+
+// sharedFilesystemQuotaV2UpdateOpts are the quotas to update. Omitted
+// quotas are kept unchanged.
+type sharedFilesystemQuotaV2UpdateOpts struct {
+	Shares            *int `json:"shares,omitempty"`
+	Gigabytes         *int `json:"gigabytes,omitempty"`
+	Snapshots         *int `json:"snapshots,omitempty"`
+	SnapshotGigabytes *int `json:"snapshot_gigabytes,omitempty"`
+	ShareNetworks     *int `json:"share_networks,omitempty"`
+}
+
+// sharedFilesystemQuotaV2Get retrieves the quotas of a project. gophercloud
+// doesn't support the Manila quota sets API yet.
+func sharedFilesystemQuotaV2Get(client *gophercloud.ServiceClient, projectID string) (*sharedFilesystemQuotaV2, error) {
+	var r gophercloud.Result
+	_, r.Err = client.Get(client.ServiceURL("quota-sets", projectID), &r.Body, nil)
+
+	var s struct {
+		QuotaSet *sharedFilesystemQuotaV2 `json:"quota_set"`
+	}
+	err := r.ExtractInto(&s)
+
+	return s.QuotaSet, err
+}
+
+// sharedFilesystemQuotaV2Update updates the quotas of a project.
+func sharedFilesystemQuotaV2Update(client *gophercloud.ServiceClient, projectID string, opts sharedFilesystemQuotaV2UpdateOpts) (*sharedFilesystemQuotaV2, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "quota_set")
+	if err != nil {
+		return nil, err
+	}
+
+	var r gophercloud.Result
+	_, r.Err = client.Put(client.ServiceURL("quota-sets", projectID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+
+	var s struct {
+		QuotaSet *sharedFilesystemQuotaV2 `json:"quota_set"`
+	}
+	err = r.ExtractInto(&s)
+
+	return s.QuotaSet, err
+}
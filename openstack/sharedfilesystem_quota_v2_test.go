@@ -0,0 +1,74 @@
+package openstack
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	thclient "github.com/gophercloud/gophercloud/testhelper/client"
+	"github.com/stretchr/testify/assert"
+)
+
+const testSharedFilesystemQuotaV2Response = `
+{
+  "quota_set": {
+    "shares": 10,
+    "gigabytes": 1000,
+    "snapshots": 50,
+    "snapshot_gigabytes": 1000,
+    "share_networks": 10
+  }
+}
+`
+
+func TestSharedFilesystemQuotaV2Get(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/quota-sets/3a705b9f56bb439381b43c4fe59dccce", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, testSharedFilesystemQuotaV2Response)
+	})
+
+	q, err := sharedFilesystemQuotaV2Get(thclient.ServiceClient(), "3a705b9f56bb439381b43c4fe59dccce")
+
+	expected := &sharedFilesystemQuotaV2{
+		Shares:            10,
+		Gigabytes:         1000,
+		Snapshots:         50,
+		SnapshotGigabytes: 1000,
+		ShareNetworks:     10,
+	}
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, q)
+}
+
+func TestSharedFilesystemQuotaV2Update(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/quota-sets/3a705b9f56bb439381b43c4fe59dccce", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestJSONRequest(t, r, `{"quota_set": {"shares": 10, "share_networks": 10}}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, testSharedFilesystemQuotaV2Response)
+	})
+
+	shares, shareNetworks := 10, 10
+	updateOpts := sharedFilesystemQuotaV2UpdateOpts{
+		Shares:        &shares,
+		ShareNetworks: &shareNetworks,
+	}
+
+	q, err := sharedFilesystemQuotaV2Update(thclient.ServiceClient(), "3a705b9f56bb439381b43c4fe59dccce", updateOpts)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 10, q.Shares)
+}
@@ -1,8 +1,17 @@
 package openstack
 
 import (
+	"io"
+
+	"github.com/gophercloud/gophercloud/openstack/containerinfra/v1/clustertemplates"
+	octavialisteners "github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/listeners"
+	octavialoadbalancers "github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/loadbalancers"
+	octaviapools "github.com/gophercloud/gophercloud/openstack/loadbalancer/v2/pools"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/floatingips"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/portforwarding"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/routers"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/l7policies"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/pools"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/subnetpools"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/vpnaas/endpointgroups"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/vpnaas/ikepolicies"
@@ -12,8 +21,44 @@ import (
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/networks"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/subnets"
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/objects"
 )
 
+// ContainerInfraClusterTemplateCreateOptsExt adds the hidden and tags
+// attributes to the cluster template create options.
+type ContainerInfraClusterTemplateCreateOptsExt struct {
+	clustertemplates.CreateOptsBuilder
+	Hidden *bool
+	Tags   string
+}
+
+// ToClusterCreateMap casts a ContainerInfraClusterTemplateCreateOptsExt
+// struct to a map. clustertemplates.CreateOptsBuilder shares the method name
+// with the clusters package.
+func (opts ContainerInfraClusterTemplateCreateOptsExt) ToClusterCreateMap() (map[string]interface{}, error) {
+	base, err := opts.CreateOptsBuilder.ToClusterCreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Hidden != nil {
+		base["hidden"] = *opts.Hidden
+	}
+
+	if opts.Tags != "" {
+		base["tags"] = opts.Tags
+	}
+
+	return base, nil
+}
+
+// ContainerInfraClusterTemplateExt represents the hidden and tags attributes
+// of a cluster template.
+type ContainerInfraClusterTemplateExt struct {
+	Hidden bool   `json:"hidden"`
+	Tags   string `json:"tags"`
+}
+
 // FloatingIPCreateOpts represents the attributes used when creating a new floating ip.
 type FloatingIPCreateOpts struct {
 	*floatingips.CreateOpts
@@ -50,6 +95,355 @@ type IKEPolicyLifetimeCreateOpts struct {
 	ValueSpecs map[string]string `json:"value_specs,omitempty"`
 }
 
+// ListenerTLSCreateOptsExt adds the alpn_protocols, tls_ciphers and client
+// authentication attributes of an Octavia listener to the create options.
+type ListenerTLSCreateOptsExt struct {
+	octavialisteners.CreateOptsBuilder
+	ALPNProtocols           []string
+	TLSCiphers              string
+	ClientAuthentication    string
+	ClientCATLSContainerRef string
+}
+
+// ToListenerCreateMap casts a ListenerTLSCreateOptsExt struct to a map.
+func (opts ListenerTLSCreateOptsExt) ToListenerCreateMap() (map[string]interface{}, error) {
+	base, err := opts.CreateOptsBuilder.ToListenerCreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	listener := base["listener"].(map[string]interface{})
+	if len(opts.ALPNProtocols) > 0 {
+		listener["alpn_protocols"] = opts.ALPNProtocols
+	}
+	if opts.TLSCiphers != "" {
+		listener["tls_ciphers"] = opts.TLSCiphers
+	}
+	if opts.ClientAuthentication != "" {
+		listener["client_authentication"] = opts.ClientAuthentication
+	}
+	if opts.ClientCATLSContainerRef != "" {
+		listener["client_ca_tls_container_ref"] = opts.ClientCATLSContainerRef
+	}
+
+	return base, nil
+}
+
+// ListenerTLSUpdateOptsExt adds the alpn_protocols, tls_ciphers and client
+// authentication attributes of an Octavia listener to the update options.
+// Empty values are sent as null, which resets them to the Octavia defaults.
+type ListenerTLSUpdateOptsExt struct {
+	octavialisteners.UpdateOptsBuilder
+	ALPNProtocols           *[]string
+	TLSCiphers              *string
+	ClientAuthentication    *string
+	ClientCATLSContainerRef *string
+}
+
+// ToListenerUpdateMap casts a ListenerTLSUpdateOptsExt struct to a map.
+func (opts ListenerTLSUpdateOptsExt) ToListenerUpdateMap() (map[string]interface{}, error) {
+	base, err := opts.UpdateOptsBuilder.ToListenerUpdateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	listener := base["listener"].(map[string]interface{})
+	if opts.ALPNProtocols != nil {
+		if len(*opts.ALPNProtocols) == 0 {
+			listener["alpn_protocols"] = nil
+		} else {
+			listener["alpn_protocols"] = *opts.ALPNProtocols
+		}
+	}
+	if opts.TLSCiphers != nil {
+		if *opts.TLSCiphers == "" {
+			listener["tls_ciphers"] = nil
+		} else {
+			listener["tls_ciphers"] = *opts.TLSCiphers
+		}
+	}
+	if opts.ClientAuthentication != nil {
+		if *opts.ClientAuthentication == "" {
+			listener["client_authentication"] = nil
+		} else {
+			listener["client_authentication"] = *opts.ClientAuthentication
+		}
+	}
+	if opts.ClientCATLSContainerRef != nil {
+		if *opts.ClientCATLSContainerRef == "" {
+			listener["client_ca_tls_container_ref"] = nil
+		} else {
+			listener["client_ca_tls_container_ref"] = *opts.ClientCATLSContainerRef
+		}
+	}
+
+	return base, nil
+}
+
+// ListenerTLSExt represents the alpn_protocols, tls_ciphers and client
+// authentication attributes of an Octavia listener.
+type ListenerTLSExt struct {
+	ALPNProtocols           []string `json:"alpn_protocols"`
+	TLSCiphers              string   `json:"tls_ciphers"`
+	ClientAuthentication    string   `json:"client_authentication"`
+	ClientCATLSContainerRef string   `json:"client_ca_tls_container_ref"`
+}
+
+// L7PolicyRedirectCreateOptsExt adds the redirect_prefix and
+// redirect_http_code attributes supported by Octavia to the L7 policy
+// create options.
+type L7PolicyRedirectCreateOptsExt struct {
+	l7policies.CreateOptsBuilder
+	RedirectPrefix   string
+	RedirectHTTPCode int
+}
+
+// ToL7PolicyCreateMap casts a L7PolicyRedirectCreateOptsExt struct to a map.
+func (opts L7PolicyRedirectCreateOptsExt) ToL7PolicyCreateMap() (map[string]interface{}, error) {
+	base, err := opts.CreateOptsBuilder.ToL7PolicyCreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	l7policy := base["l7policy"].(map[string]interface{})
+	if opts.RedirectPrefix != "" {
+		l7policy["redirect_prefix"] = opts.RedirectPrefix
+	}
+	if opts.RedirectHTTPCode != 0 {
+		l7policy["redirect_http_code"] = opts.RedirectHTTPCode
+	}
+
+	return base, nil
+}
+
+// L7PolicyRedirectUpdateOptsExt adds the redirect_prefix and
+// redirect_http_code attributes supported by Octavia to the L7 policy
+// update options.
+type L7PolicyRedirectUpdateOptsExt struct {
+	l7policies.UpdateOptsBuilder
+	RedirectPrefix   *string
+	RedirectHTTPCode int
+}
+
+// ToL7PolicyUpdateMap casts a L7PolicyRedirectUpdateOptsExt struct to a map.
+func (opts L7PolicyRedirectUpdateOptsExt) ToL7PolicyUpdateMap() (map[string]interface{}, error) {
+	base, err := opts.UpdateOptsBuilder.ToL7PolicyUpdateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	l7policy := base["l7policy"].(map[string]interface{})
+	if opts.RedirectPrefix != nil {
+		if *opts.RedirectPrefix == "" {
+			l7policy["redirect_prefix"] = nil
+		} else {
+			l7policy["redirect_prefix"] = *opts.RedirectPrefix
+		}
+	}
+	if opts.RedirectHTTPCode != 0 {
+		l7policy["redirect_http_code"] = opts.RedirectHTTPCode
+	}
+
+	return base, nil
+}
+
+// L7PolicyRedirectExt represents the redirect_prefix and redirect_http_code
+// attributes of an L7 policy.
+type L7PolicyRedirectExt struct {
+	RedirectPrefix   string `json:"redirect_prefix"`
+	RedirectHTTPCode int    `json:"redirect_http_code"`
+}
+
+// LoadBalancerAdditionalVIP represents an additional VIP of a load balancer.
+type LoadBalancerAdditionalVIP struct {
+	SubnetID  string `json:"subnet_id"`
+	IPAddress string `json:"ip_address,omitempty"`
+}
+
+// LoadBalancerAdditionalVIPsCreateOptsExt adds the additional_vips attribute
+// of an Octavia load balancer to the create options.
+type LoadBalancerAdditionalVIPsCreateOptsExt struct {
+	octavialoadbalancers.CreateOptsBuilder
+	AdditionalVIPs []LoadBalancerAdditionalVIP
+}
+
+// ToLoadBalancerCreateMap casts a LoadBalancerAdditionalVIPsCreateOptsExt
+// struct to a map.
+func (opts LoadBalancerAdditionalVIPsCreateOptsExt) ToLoadBalancerCreateMap() (map[string]interface{}, error) {
+	base, err := opts.CreateOptsBuilder.ToLoadBalancerCreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	loadbalancer := base["loadbalancer"].(map[string]interface{})
+	if len(opts.AdditionalVIPs) > 0 {
+		loadbalancer["additional_vips"] = opts.AdditionalVIPs
+	}
+
+	return base, nil
+}
+
+// LoadBalancerAdditionalVIPsExt represents the additional_vips attribute of
+// an Octavia load balancer.
+type LoadBalancerAdditionalVIPsExt struct {
+	AdditionalVIPs []LoadBalancerAdditionalVIP `json:"additional_vips"`
+}
+
+// MemberMonitorUpdateOptsExt adds the monitor_address and monitor_port
+// attributes of an Octavia member to the member update options. Unlike
+// octaviapools.UpdateMemberOpts, an empty monitor_address or monitor_port is
+// sent as null, which unsets it.
+type MemberMonitorUpdateOptsExt struct {
+	octaviapools.UpdateMemberOptsBuilder
+	MonitorAddress *string
+	MonitorPort    *int
+}
+
+// ToMemberUpdateMap casts a MemberMonitorUpdateOptsExt struct to a map.
+func (opts MemberMonitorUpdateOptsExt) ToMemberUpdateMap() (map[string]interface{}, error) {
+	base, err := opts.UpdateMemberOptsBuilder.ToMemberUpdateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	member := base["member"].(map[string]interface{})
+	if opts.MonitorAddress != nil {
+		if *opts.MonitorAddress == "" {
+			member["monitor_address"] = nil
+		} else {
+			member["monitor_address"] = *opts.MonitorAddress
+		}
+	}
+	if opts.MonitorPort != nil {
+		if *opts.MonitorPort == 0 {
+			member["monitor_port"] = nil
+		} else {
+			member["monitor_port"] = *opts.MonitorPort
+		}
+	}
+
+	return base, nil
+}
+
+// MemberExt represents the backup, monitor_address and monitor_port
+// attributes of a member.
+type MemberExt struct {
+	Backup         bool   `json:"backup"`
+	MonitorAddress string `json:"monitor_address"`
+	MonitorPort    int    `json:"monitor_port"`
+}
+
+// ObjectSymlinkCreateOptsExt adds the symlink headers to the object create
+// options.
+type ObjectSymlinkCreateOptsExt struct {
+	objects.CreateOptsBuilder
+	SymlinkTarget        string
+	SymlinkTargetAccount string
+}
+
+// ToObjectCreateParams formats an ObjectSymlinkCreateOptsExt into a query
+// string and a map of headers.
+func (opts ObjectSymlinkCreateOptsExt) ToObjectCreateParams() (io.Reader, map[string]string, string, error) {
+	content, headers, query, err := opts.CreateOptsBuilder.ToObjectCreateParams()
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	if headers == nil {
+		headers = make(map[string]string)
+	}
+
+	if opts.SymlinkTarget != "" {
+		headers["X-Symlink-Target"] = opts.SymlinkTarget
+	}
+
+	if opts.SymlinkTargetAccount != "" {
+		headers["X-Symlink-Target-Account"] = opts.SymlinkTargetAccount
+	}
+
+	return content, headers, query, nil
+}
+
+// ObjectSymlinkGetOptsExt adds the symlink=get query parameter to the object
+// get options, so that a symlink itself is retrieved instead of its target.
+type ObjectSymlinkGetOptsExt struct {
+	objects.GetOptsBuilder
+}
+
+// ToObjectGetParams formats an ObjectSymlinkGetOptsExt into a query string
+// and a map of headers.
+func (opts ObjectSymlinkGetOptsExt) ToObjectGetParams() (map[string]string, string, error) {
+	headers, query, err := opts.GetOptsBuilder.ToObjectGetParams()
+	if err != nil {
+		return nil, "", err
+	}
+
+	if query == "" {
+		query = "?symlink=get"
+	} else {
+		query += "&symlink=get"
+	}
+
+	return headers, query, nil
+}
+
+// PoolSessionPersistence represents the session persistence of a pool,
+// including the persistence_timeout attribute supported by Octavia.
+type PoolSessionPersistence struct {
+	Type               string `json:"type"`
+	CookieName         string `json:"cookie_name,omitempty"`
+	PersistenceTimeout int    `json:"persistence_timeout,omitempty"`
+}
+
+// PoolPersistenceCreateOptsExt replaces the session persistence of the pool
+// create options with a PoolSessionPersistence.
+type PoolPersistenceCreateOptsExt struct {
+	pools.CreateOptsBuilder
+	Persistence *PoolSessionPersistence
+}
+
+// ToPoolCreateMap casts a PoolPersistenceCreateOptsExt struct to a map.
+func (opts PoolPersistenceCreateOptsExt) ToPoolCreateMap() (map[string]interface{}, error) {
+	base, err := opts.CreateOptsBuilder.ToPoolCreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	pool := base["pool"].(map[string]interface{})
+	if opts.Persistence != nil {
+		pool["session_persistence"] = opts.Persistence
+	}
+
+	return base, nil
+}
+
+// PoolPersistenceUpdateOptsExt adds the session persistence to the pool
+// update options. A PoolSessionPersistence without a type is sent as null,
+// which disables session persistence.
+type PoolPersistenceUpdateOptsExt struct {
+	pools.UpdateOptsBuilder
+	Persistence *PoolSessionPersistence
+}
+
+// ToPoolUpdateMap casts a PoolPersistenceUpdateOptsExt struct to a map.
+func (opts PoolPersistenceUpdateOptsExt) ToPoolUpdateMap() (map[string]interface{}, error) {
+	base, err := opts.UpdateOptsBuilder.ToPoolUpdateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	pool := base["pool"].(map[string]interface{})
+	if opts.Persistence != nil {
+		if opts.Persistence.Type == "" {
+			pool["session_persistence"] = nil
+		} else {
+			pool["session_persistence"] = opts.Persistence
+		}
+	}
+
+	return base, nil
+}
+
 // PortCreateOpts represents the attributes used when creating a new port.
 type PortCreateOpts struct {
 	ports.CreateOpts
@@ -62,9 +456,272 @@ func (opts PortCreateOpts) ToPortCreateMap() (map[string]interface{}, error) {
 	return BuildRequest(opts, "port")
 }
 
+// PortDeviceProfileCreateOptsExt adds the device_profile attribute of the
+// port-device-profile extension to the port create options.
+type PortDeviceProfileCreateOptsExt struct {
+	ports.CreateOptsBuilder
+	DeviceProfile string
+}
+
+// ToPortCreateMap casts a PortDeviceProfileCreateOptsExt struct to a map.
+func (opts PortDeviceProfileCreateOptsExt) ToPortCreateMap() (map[string]interface{}, error) {
+	base, err := opts.CreateOptsBuilder.ToPortCreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	port := base["port"].(map[string]interface{})
+	if opts.DeviceProfile != "" {
+		port["device_profile"] = opts.DeviceProfile
+	}
+
+	return base, nil
+}
+
+// PortDeviceProfileExt represents the device_profile attribute of a port.
+type PortDeviceProfileExt struct {
+	DeviceProfile string `json:"device_profile"`
+}
+
+// PortForwardingCreateOptsExt adds the description and the port ranges of the
+// floating-ip-port-forwarding-port-ranges extension to the port forwarding
+// create options.
+type PortForwardingCreateOptsExt struct {
+	portforwarding.CreateOptsBuilder
+	Description       string
+	InternalPortRange string
+	ExternalPortRange string
+}
+
+// ToPortForwardingCreateMap casts a PortForwardingCreateOptsExt struct to a map.
+func (opts PortForwardingCreateOptsExt) ToPortForwardingCreateMap() (map[string]interface{}, error) {
+	base, err := opts.CreateOptsBuilder.ToPortForwardingCreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	pf := base["port_forwarding"].(map[string]interface{})
+	if opts.Description != "" {
+		pf["description"] = opts.Description
+	}
+
+	// a port range replaces the single port
+	if opts.InternalPortRange != "" {
+		delete(pf, "internal_port")
+		pf["internal_port_range"] = opts.InternalPortRange
+	}
+
+	if opts.ExternalPortRange != "" {
+		delete(pf, "external_port")
+		pf["external_port_range"] = opts.ExternalPortRange
+	}
+
+	return base, nil
+}
+
+// PortForwardingUpdateOptsExt adds the description and the port ranges of the
+// floating-ip-port-forwarding-port-ranges extension to the port forwarding
+// update options.
+type PortForwardingUpdateOptsExt struct {
+	portforwarding.UpdateOptsBuilder
+	Description       *string
+	InternalPortRange string
+	ExternalPortRange string
+}
+
+// ToPortForwardingUpdateMap casts a PortForwardingUpdateOptsExt struct to a map.
+func (opts PortForwardingUpdateOptsExt) ToPortForwardingUpdateMap() (map[string]interface{}, error) {
+	base, err := opts.UpdateOptsBuilder.ToPortForwardingUpdateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	pf := base["port_forwarding"].(map[string]interface{})
+	if opts.Description != nil {
+		pf["description"] = *opts.Description
+	}
+
+	if opts.InternalPortRange != "" {
+		delete(pf, "internal_port")
+		pf["internal_port_range"] = opts.InternalPortRange
+	}
+
+	if opts.ExternalPortRange != "" {
+		delete(pf, "external_port")
+		pf["external_port_range"] = opts.ExternalPortRange
+	}
+
+	return base, nil
+}
+
+// PortForwardingExt represents the description and the port ranges of a port
+// forwarding.
+type PortForwardingExt struct {
+	Description       string `json:"description"`
+	InternalPortRange string `json:"internal_port_range"`
+	ExternalPortRange string `json:"external_port_range"`
+}
+
+// PortHintsCreateOptsExt adds the hints attribute of the port-hints
+// extension to the port create options.
+type PortHintsCreateOptsExt struct {
+	ports.CreateOptsBuilder
+	Hints map[string]interface{}
+}
+
+// ToPortCreateMap casts a PortHintsCreateOptsExt struct to a map.
+func (opts PortHintsCreateOptsExt) ToPortCreateMap() (map[string]interface{}, error) {
+	base, err := opts.CreateOptsBuilder.ToPortCreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	port := base["port"].(map[string]interface{})
+	if opts.Hints != nil {
+		port["hints"] = opts.Hints
+	}
+
+	return base, nil
+}
+
+// PortHintsUpdateOptsExt adds the hints attribute of the port-hints
+// extension to the port update options.
+type PortHintsUpdateOptsExt struct {
+	ports.UpdateOptsBuilder
+	Hints map[string]interface{}
+}
+
+// ToPortUpdateMap casts a PortHintsUpdateOptsExt struct to a map.
+// Empty hints are sent as null in order to unset them.
+func (opts PortHintsUpdateOptsExt) ToPortUpdateMap() (map[string]interface{}, error) {
+	base, err := opts.UpdateOptsBuilder.ToPortUpdateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	port := base["port"].(map[string]interface{})
+	if len(opts.Hints) > 0 {
+		port["hints"] = opts.Hints
+	} else {
+		port["hints"] = nil
+	}
+
+	return base, nil
+}
+
+// PortHintsExt represents the hints attribute of a port.
+type PortHintsExt struct {
+	Hints map[string]interface{} `json:"hints"`
+}
+
+// PortMACLearningCreateOptsExt adds the mac_learning_enabled attribute of
+// the MAC learning extension to the port create options.
+type PortMACLearningCreateOptsExt struct {
+	ports.CreateOptsBuilder
+	MACLearningEnabled *bool
+}
+
+// ToPortCreateMap casts a PortMACLearningCreateOptsExt struct to a map.
+func (opts PortMACLearningCreateOptsExt) ToPortCreateMap() (map[string]interface{}, error) {
+	base, err := opts.CreateOptsBuilder.ToPortCreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	port := base["port"].(map[string]interface{})
+	if opts.MACLearningEnabled != nil {
+		port["mac_learning_enabled"] = *opts.MACLearningEnabled
+	}
+
+	return base, nil
+}
+
+// PortMACLearningUpdateOptsExt adds the mac_learning_enabled attribute of
+// the MAC learning extension to the port update options.
+type PortMACLearningUpdateOptsExt struct {
+	ports.UpdateOptsBuilder
+	MACLearningEnabled *bool
+}
+
+// ToPortUpdateMap casts a PortMACLearningUpdateOptsExt struct to a map.
+func (opts PortMACLearningUpdateOptsExt) ToPortUpdateMap() (map[string]interface{}, error) {
+	base, err := opts.UpdateOptsBuilder.ToPortUpdateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	port := base["port"].(map[string]interface{})
+	if opts.MACLearningEnabled != nil {
+		port["mac_learning_enabled"] = *opts.MACLearningEnabled
+	}
+
+	return base, nil
+}
+
+// PortMACLearningExt represents the mac_learning_enabled attribute of a port.
+type PortMACLearningExt struct {
+	MACLearningEnabled *bool `json:"mac_learning_enabled"`
+}
+
+// PortNUMAAffinityPolicyCreateOptsExt adds the numa_affinity_policy attribute
+// of the port-numa-affinity-policy extension to the port create options.
+type PortNUMAAffinityPolicyCreateOptsExt struct {
+	ports.CreateOptsBuilder
+	NUMAAffinityPolicy string
+}
+
+// ToPortCreateMap casts a PortNUMAAffinityPolicyCreateOptsExt struct to a map.
+func (opts PortNUMAAffinityPolicyCreateOptsExt) ToPortCreateMap() (map[string]interface{}, error) {
+	base, err := opts.CreateOptsBuilder.ToPortCreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	port := base["port"].(map[string]interface{})
+	if opts.NUMAAffinityPolicy != "" {
+		port["numa_affinity_policy"] = opts.NUMAAffinityPolicy
+	}
+
+	return base, nil
+}
+
+// PortNUMAAffinityPolicyUpdateOptsExt adds the numa_affinity_policy attribute
+// of the port-numa-affinity-policy extension to the port update options.
+type PortNUMAAffinityPolicyUpdateOptsExt struct {
+	ports.UpdateOptsBuilder
+	NUMAAffinityPolicy *string
+}
+
+// ToPortUpdateMap casts a PortNUMAAffinityPolicyUpdateOptsExt struct to a map.
+// An empty policy is sent as null in order to unset it.
+func (opts PortNUMAAffinityPolicyUpdateOptsExt) ToPortUpdateMap() (map[string]interface{}, error) {
+	base, err := opts.UpdateOptsBuilder.ToPortUpdateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	port := base["port"].(map[string]interface{})
+	if opts.NUMAAffinityPolicy != nil {
+		if *opts.NUMAAffinityPolicy == "" {
+			port["numa_affinity_policy"] = nil
+		} else {
+			port["numa_affinity_policy"] = *opts.NUMAAffinityPolicy
+		}
+	}
+
+	return base, nil
+}
+
+// PortNUMAAffinityPolicyExt represents the numa_affinity_policy attribute of
+// a port.
+type PortNUMAAffinityPolicyExt struct {
+	NUMAAffinityPolicy string `json:"numa_affinity_policy"`
+}
+
 // RouterCreateOpts represents the attributes used when creating a new router.
 type RouterCreateOpts struct {
 	routers.CreateOpts
+	HA         *bool             `json:"ha,omitempty"`
 	ValueSpecs map[string]string `json:"value_specs,omitempty"`
 }
 
@@ -74,6 +731,27 @@ func (opts RouterCreateOpts) ToRouterCreateMap() (map[string]interface{}, error)
 	return BuildRequest(opts, "router")
 }
 
+// RouterHAUpdateOptsExt adds the ha attribute of the l3-ha extension to the
+// router update options.
+type RouterHAUpdateOptsExt struct {
+	routers.UpdateOptsBuilder
+	HA *bool
+}
+
+// ToRouterUpdateMap casts a RouterHAUpdateOptsExt struct to a map.
+func (opts RouterHAUpdateOptsExt) ToRouterUpdateMap() (map[string]interface{}, error) {
+	base, err := opts.UpdateOptsBuilder.ToRouterUpdateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.HA != nil {
+		base["router"].(map[string]interface{})["ha"] = *opts.HA
+	}
+
+	return base, nil
+}
+
 // SubnetCreateOpts represents the attributes used when creating a new subnet.
 type SubnetCreateOpts struct {
 	subnets.CreateOpts
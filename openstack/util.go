@@ -1,13 +1,23 @@
 package openstack
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"path"
+	"reflect"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/gophercloud/gophercloud"
+	osClient "github.com/gophercloud/utils/client"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
@@ -25,12 +35,19 @@ func BuildRequest(opts interface{}, parent string) (map[string]interface{}, erro
 	return map[string]interface{}{parent: b}, nil
 }
 
-// CheckDeleted checks the error to see if it's a 404 (Not Found) and, if so,
-// sets the resource ID to the empty string instead of throwing an error.
+// CheckDeleted checks the error to see if it's a 404 (Not Found) or a 410
+// (Gone) and, if so, sets the resource ID to the empty string instead of
+// throwing an error.
 func CheckDeleted(d *schema.ResourceData, err error, msg string) error {
-	if _, ok := err.(gophercloud.ErrDefault404); ok {
+	switch e := err.(type) {
+	case gophercloud.ErrDefault404:
 		d.SetId("")
 		return nil
+	case gophercloud.ErrUnexpectedResponseCode:
+		if e.Actual == http.StatusGone {
+			d.SetId("")
+			return nil
+		}
 	}
 
 	return fmt.Errorf("%s %s: %s", msg, d.Id(), err)
@@ -47,6 +64,71 @@ func GetRegion(d *schema.ResourceData, config *Config) string {
 	return config.Region
 }
 
+// parseQuotaID parses the id of the quota resource resourceName. Depending on
+// the provider version the resource was created or imported with, the id can
+// be either <project_id> or <project_id>/<region>. The region is empty for
+// the former.
+func parseQuotaID(resourceName, id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+
+	projectID := parts[0]
+	if projectID == "" {
+		return "", "", fmt.Errorf("Invalid %s id %q. Format must be <project_id>/<region>", resourceName, id)
+	}
+
+	if len(parts) == 1 {
+		return projectID, "", nil
+	}
+
+	region := parts[1]
+	if region == "" {
+		return "", "", fmt.Errorf("Invalid %s id %q. Format must be <project_id>/<region>", resourceName, id)
+	}
+
+	return projectID, region, nil
+}
+
+// quotaID returns the id of the quota resource resourceName. A legacy
+// <project_id> id gets the region appended, an id which already contains a
+// region is returned unchanged.
+func quotaID(resourceName, id, region string) (string, error) {
+	projectID, idRegion, err := parseQuotaID(resourceName, id)
+	if err != nil {
+		return "", err
+	}
+
+	if idRegion != "" {
+		return id, nil
+	}
+
+	return fmt.Sprintf("%s/%s", projectID, region), nil
+}
+
+// importQuota returns the importer of the quota resource resourceName, which
+// accepts either <project_id> or <project_id>/<region>. The region of the
+// provider is used, when it's omitted.
+func importQuota(resourceName string) schema.StateFunc {
+	return func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		config := meta.(*Config)
+
+		id, err := quotaID(resourceName, d.Id(), GetRegion(d, config))
+		if err != nil {
+			return nil, err
+		}
+
+		projectID, region, err := parseQuotaID(resourceName, id)
+		if err != nil {
+			return nil, err
+		}
+
+		d.SetId(id)
+		d.Set("project_id", projectID)
+		d.Set("region", region)
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
 // AddValueSpecs expands the 'value_specs' object and removes 'value_specs'
 // from the reqeust body.
 func AddValueSpecs(body map[string]interface{}) map[string]interface{} {
@@ -69,6 +151,9 @@ func MapValueSpecs(d *schema.ResourceData) map[string]string {
 	return m
 }
 
+// checkForRetryableError marks 409, 500 and 503 responses as retryable for
+// resource.Retry. resource.Retry already waits between the attempts, doubling
+// the delay from 500ms up to 10s, so callers don't need a backoff of their own.
 func checkForRetryableError(err error) *resource.RetryError {
 	switch err.(type) {
 	case gophercloud.ErrDefault500:
@@ -82,6 +167,40 @@ func checkForRetryableError(err error) *resource.RetryError {
 	}
 }
 
+// waitForStateContext works like stateConf.WaitForState, but returns as soon
+// as ctx is done, e.g. because Terraform was interrupted. The refresh function
+// is wrapped as well, so that the polling stops with it.
+func waitForStateContext(ctx context.Context, stateConf *resource.StateChangeConf) (interface{}, error) {
+	if ctx == nil {
+		return stateConf.WaitForState()
+	}
+
+	refresh := stateConf.Refresh
+	stateConf.Refresh = func() (interface{}, string, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, "", err
+		}
+		return refresh()
+	}
+
+	type waitResult struct {
+		result interface{}
+		err    error
+	}
+	done := make(chan waitResult, 1)
+	go func() {
+		result, err := stateConf.WaitForState()
+		done <- waitResult{result, err}
+	}()
+
+	select {
+	case r := <-done:
+		return r.result, r.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
 func suppressEquivalentTimeDiffs(k, old, new string, d *schema.ResourceData) bool {
 	oldTime, err := time.Parse(time.RFC3339, old)
 	if err != nil {
@@ -275,12 +394,282 @@ func validateJSONObject(v interface{}, k string) ([]string, []error) {
 	return nil, nil
 }
 
+// httpTransportOpts are the connection pool settings of the provider HTTP
+// transport. Zero values keep the transport defaults.
+type httpTransportOpts struct {
+	MaxIdleConns        int
+	MaxIdleConnsPerHost int
+	IdleConnTimeout     time.Duration
+}
+
+// configureHTTPTransport applies opts to the *http.Transport wrapped by rt,
+// which can be wrapped by the gophercloud debug logger or by the
+// semaphoreRoundTripper.
+func configureHTTPTransport(rt http.RoundTripper, opts httpTransportOpts) error {
+	switch t := rt.(type) {
+	case *http.Transport:
+		if opts.MaxIdleConns > 0 {
+			t.MaxIdleConns = opts.MaxIdleConns
+		}
+		if opts.MaxIdleConnsPerHost > 0 {
+			t.MaxIdleConnsPerHost = opts.MaxIdleConnsPerHost
+		}
+		if opts.IdleConnTimeout > 0 {
+			t.IdleConnTimeout = opts.IdleConnTimeout
+		}
+		return nil
+	case *osClient.RoundTripper:
+		return configureHTTPTransport(t.Rt, opts)
+	case *semaphoreRoundTripper:
+		return configureHTTPTransport(t.rt, opts)
+	case *versionChoicesRoundTripper:
+		return configureHTTPTransport(t.rt, opts)
+	case *requestTimeoutRoundTripper:
+		return configureHTTPTransport(t.rt, opts)
+	}
+
+	return fmt.Errorf("Unable to configure the HTTP transport: unexpected %T", rt)
+}
+
+// semaphoreRoundTripper limits the number of concurrent requests sent
+// through the wrapped http.RoundTripper. Requests beyond the limit wait for
+// a free slot.
+type semaphoreRoundTripper struct {
+	rt  http.RoundTripper
+	sem chan struct{}
+}
+
+// newSemaphoreRoundTripper wraps rt, so that at most limit requests are
+// in flight at the same time. A nil rt means http.DefaultTransport.
+func newSemaphoreRoundTripper(rt http.RoundTripper, limit int) *semaphoreRoundTripper {
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+
+	return &semaphoreRoundTripper{
+		rt:  rt,
+		sem: make(chan struct{}, limit),
+	}
+}
+
+// RoundTrip acquires a slot, executes the request and releases the slot.
+func (s *semaphoreRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	select {
+	case s.sem <- struct{}{}:
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	}
+	defer func() { <-s.sem }()
+
+	return s.rt.RoundTrip(req)
+}
+
+// requestTimeoutRoundTripper aborts a single attempt of a request, including
+// reading its response, after timeout. Requests and responses with a binary
+// body, such as image and object uploads or downloads, are only limited until
+// the response headers arrive.
+type requestTimeoutRoundTripper struct {
+	rt      http.RoundTripper
+	timeout time.Duration
+}
+
+// withRequestTimeout wraps the innermost transport of rt, so that the timeout
+// starts once a semaphoreRoundTripper slot is acquired and applies to every
+// attempt of the gophercloud retrying round tripper.
+func withRequestTimeout(rt http.RoundTripper, timeout time.Duration) http.RoundTripper {
+	switch t := rt.(type) {
+	case *osClient.RoundTripper:
+		t.Rt = withRequestTimeout(t.Rt, timeout)
+		return t
+	case *semaphoreRoundTripper:
+		t.rt = withRequestTimeout(t.rt, timeout)
+		return t
+	case *versionChoicesRoundTripper:
+		t.rt = withRequestTimeout(t.rt, timeout)
+		return t
+	}
+
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+
+	return &requestTimeoutRoundTripper{
+		rt:      rt,
+		timeout: timeout,
+	}
+}
+
+// RoundTrip executes the request with a deadline.
+func (r *requestTimeoutRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if isBinaryHTTPBody(req.Header, req.ContentLength) {
+		return r.rt.RoundTrip(req)
+	}
+
+	ctx, cancel := context.WithCancel(req.Context())
+	timer := time.AfterFunc(r.timeout, cancel)
+
+	resp, err := r.rt.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		timer.Stop()
+		cancel()
+		return nil, err
+	}
+
+	if isBinaryHTTPBody(resp.Header, resp.ContentLength) {
+		timer.Stop()
+	}
+
+	resp.Body = &cancelReadCloser{ReadCloser: resp.Body, cancel: func() {
+		timer.Stop()
+		cancel()
+	}}
+
+	return resp, nil
+}
+
+// isBinaryHTTPBody returns true if the headers describe a non-empty body,
+// which isn't JSON or text.
+func isBinaryHTTPBody(header http.Header, contentLength int64) bool {
+	if contentLength == 0 {
+		return false
+	}
+
+	contentType := header.Get("Content-Type")
+	return contentType != "" && !strings.Contains(contentType, "json") && !strings.HasPrefix(contentType, "text/")
+}
+
+// cancelReadCloser releases the context of a request once its response body
+// is closed.
+type cancelReadCloser struct {
+	io.ReadCloser
+	cancel func()
+}
+
+func (c *cancelReadCloser) Close() error {
+	err := c.ReadCloser.Close()
+	c.cancel()
+	return err
+}
+
+// versionChoicesRoundTripper handles the 300 Multiple Choices responses of
+// the API version discovery, e.g. of an unversioned identity auth URL. Clouds
+// behind a proxy often advertise their internal endpoints there, so the
+// version links pointing to another host are rebased onto the requested URL.
+type versionChoicesRoundTripper struct {
+	rt http.RoundTripper
+}
+
+// RoundTrip executes the request and rewrites a version discovery response.
+func (v *versionChoicesRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := v.rt.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusMultipleChoices {
+		return resp, err
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	if rewritten, ok := rewriteVersionChoices(body, req.URL); ok {
+		log.Printf("[DEBUG] Resolved the API version choices of %s", req.URL)
+		body = rewritten
+		resp.StatusCode = http.StatusOK
+		resp.Status = fmt.Sprintf("%d %s", http.StatusOK, http.StatusText(http.StatusOK))
+		resp.ContentLength = int64(len(body))
+		resp.Header.Del("Content-Length")
+	}
+
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	return resp, nil
+}
+
+// rewriteVersionChoices rebases the self links of a version discovery
+// document onto base. It returns false if body is not such a document.
+func rewriteVersionChoices(body []byte, base *url.URL) ([]byte, bool) {
+	var doc map[string]interface{}
+	if err := json.Unmarshal(body, &doc); err != nil {
+		return nil, false
+	}
+
+	versions, ok := doc["versions"].(map[string]interface{})
+	if !ok {
+		return nil, false
+	}
+
+	values, ok := versions["values"].([]interface{})
+	if !ok {
+		return nil, false
+	}
+
+	for _, value := range values {
+		version, ok := value.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		links, _ := version["links"].([]interface{})
+		for _, l := range links {
+			link, ok := l.(map[string]interface{})
+			if !ok || link["rel"] != "self" {
+				continue
+			}
+
+			if href, ok := link["href"].(string); ok {
+				link["href"] = rebaseVersionHref(href, base)
+			}
+		}
+	}
+
+	rewritten, err := json.Marshal(doc)
+	if err != nil {
+		return nil, false
+	}
+
+	return rewritten, true
+}
+
+// rebaseVersionHref moves a version link, e.g. http://10.0.0.1:5000/v3/,
+// below base, e.g. https://cloud.example.com/identity/v3/, if it points to
+// another host.
+func rebaseVersionHref(href string, base *url.URL) string {
+	u, err := url.Parse(href)
+	if err != nil || u.Host == "" || u.Host == base.Host {
+		return href
+	}
+
+	version := path.Base(strings.TrimSuffix(u.Path, "/"))
+	if version == "." || version == "/" {
+		return href
+	}
+
+	rebased := *base
+	rebased.Path = strings.TrimSuffix(base.Path, "/") + "/" + version + "/"
+	rebased.RawPath = ""
+	rebased.RawQuery = ""
+
+	return rebased.String()
+}
+
+// diffSuppressJSONObject suppresses the diff between two JSON objects, which
+// differ only by formatting or key order.
 func diffSuppressJSONObject(k, old, new string, d *schema.ResourceData) bool {
 	if strSliceContains([]string{"{}", ""}, old) &&
 		strSliceContains([]string{"{}", ""}, new) {
 		return true
 	}
-	return false
+
+	var oldObject, newObject map[string]interface{}
+	if err := json.Unmarshal([]byte(old), &oldObject); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(new), &newObject); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(oldObject, newObject)
 }
 
 // Metadata in openstack are not fully replaced with a "set"
@@ -1,8 +1,26 @@
 package openstack
 
 import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/gophercloud/gophercloud"
+	osUtils "github.com/gophercloud/gophercloud/openstack/utils"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	osClient "github.com/gophercloud/utils/client"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -81,3 +99,408 @@ func TestMapDiffWithNilValues(t *testing.T) {
 	assert.Equal(t, result["c"], "3")
 	assert.Equal(t, len(result), 3)
 }
+
+func TestValidateJSONObject(t *testing.T) {
+	validValues := []string{
+		`{}`,
+		`{"foo": "bar"}`,
+		`{"foo": {"bar": ["baz", 1]}}`,
+	}
+	for _, v := range validValues {
+		_, errs := validateJSONObject(v, "profile")
+		assert.Empty(t, errs, v)
+	}
+
+	invalidValues := []string{
+		``,
+		`foo`,
+		`["foo", "bar"]`,
+		`{"foo": "bar"`,
+	}
+	for _, v := range invalidValues {
+		_, errs := validateJSONObject(v, "profile")
+		assert.NotEmpty(t, errs, v)
+	}
+}
+
+func TestDiffSuppressJSONObject(t *testing.T) {
+	assert.True(t, diffSuppressJSONObject("profile", "", "{}", nil))
+	assert.True(t, diffSuppressJSONObject("profile", "{}", "", nil))
+	assert.True(t, diffSuppressJSONObject("profile",
+		`{"foo":"bar","baz":{"qux":1}}`,
+		`{
+  "baz": {"qux": 1},
+  "foo": "bar"
+}`, nil))
+	assert.False(t, diffSuppressJSONObject("profile", `{"foo":"bar"}`, `{"foo":"baz"}`, nil))
+	assert.False(t, diffSuppressJSONObject("profile", `{"foo":"bar"}`, "", nil))
+	assert.False(t, diffSuppressJSONObject("profile", `{"foo":"bar"}`, `{"foo":`, nil))
+}
+
+func TestCheckDeleted(t *testing.T) {
+	newResourceData := func() *schema.ResourceData {
+		d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]interface{}{})
+		d.SetId("id")
+		return d
+	}
+
+	d := newResourceData()
+	err := CheckDeleted(d, gophercloud.ErrDefault404{}, "Error getting resource")
+	assert.NoError(t, err)
+	assert.Equal(t, "", d.Id())
+
+	d = newResourceData()
+	err = CheckDeleted(d, gophercloud.ErrUnexpectedResponseCode{Actual: http.StatusGone}, "Error getting resource")
+	assert.NoError(t, err)
+	assert.Equal(t, "", d.Id())
+
+	d = newResourceData()
+	err = CheckDeleted(d, gophercloud.ErrDefault403{}, "Error getting resource")
+	assert.Error(t, err)
+	assert.Equal(t, "id", d.Id())
+
+	d = newResourceData()
+	err = CheckDeleted(d, gophercloud.ErrDefault500{}, "Error getting resource")
+	assert.Error(t, err)
+	assert.Equal(t, "id", d.Id())
+}
+
+func TestWaitForStateContext_canceled(t *testing.T) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"BUILD"},
+		Target:  []string{"ACTIVE"},
+		Refresh: func() (interface{}, string, error) {
+			return "instance", "BUILD", nil
+		},
+		Timeout:    time.Hour,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(100*time.Millisecond, cancel)
+
+	start := time.Now()
+	_, err := waitForStateContext(ctx, stateConf)
+
+	assert.Equal(t, context.Canceled, err)
+	assert.True(t, time.Since(start) < 5*time.Second)
+}
+
+type testConcurrencyRoundTripper struct {
+	current int32
+	max     int32
+}
+
+func (rt *testConcurrencyRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	current := atomic.AddInt32(&rt.current, 1)
+	defer atomic.AddInt32(&rt.current, -1)
+
+	for {
+		max := atomic.LoadInt32(&rt.max)
+		if current <= max || atomic.CompareAndSwapInt32(&rt.max, max, current) {
+			break
+		}
+	}
+
+	time.Sleep(10 * time.Millisecond)
+
+	return httptest.NewRecorder().Result(), nil
+}
+
+func TestSemaphoreRoundTripper(t *testing.T) {
+	limit := 3
+	rt := &testConcurrencyRoundTripper{}
+	client := &http.Client{Transport: newSemaphoreRoundTripper(rt, limit)}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := client.Get("http://localhost")
+			assert.NoError(t, err)
+			if err == nil {
+				resp.Body.Close()
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.LessOrEqual(t, atomic.LoadInt32(&rt.max), int32(limit))
+}
+
+func TestSemaphoreRoundTripper_canceled(t *testing.T) {
+	rt := newSemaphoreRoundTripper(&testConcurrencyRoundTripper{}, 1)
+
+	// Occupy the only slot.
+	rt.sem <- struct{}{}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	req, err := http.NewRequest("GET", "http://localhost", nil)
+	assert.NoError(t, err)
+
+	_, err = rt.RoundTrip(req.WithContext(ctx))
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestConfigureHTTPTransport(t *testing.T) {
+	opts := httpTransportOpts{
+		MaxIdleConns:        200,
+		MaxIdleConnsPerHost: 20,
+		IdleConnTimeout:     2 * time.Minute,
+	}
+
+	transport := &http.Transport{}
+	rt := newSemaphoreRoundTripper(&osClient.RoundTripper{Rt: transport}, 1)
+
+	assert.NoError(t, configureHTTPTransport(rt, opts))
+	assert.Equal(t, 200, transport.MaxIdleConns)
+	assert.Equal(t, 20, transport.MaxIdleConnsPerHost)
+	assert.Equal(t, 2*time.Minute, transport.IdleConnTimeout)
+}
+
+func TestConfigureHTTPTransport_defaults(t *testing.T) {
+	transport := &http.Transport{
+		MaxIdleConns:    100,
+		IdleConnTimeout: 90 * time.Second,
+	}
+
+	assert.NoError(t, configureHTTPTransport(transport, httpTransportOpts{}))
+	assert.Equal(t, 100, transport.MaxIdleConns)
+	assert.Equal(t, 0, transport.MaxIdleConnsPerHost)
+	assert.Equal(t, 90*time.Second, transport.IdleConnTimeout)
+
+	assert.Error(t, configureHTTPTransport(nil, httpTransportOpts{}))
+}
+
+// testCancelableRoundTripper records whether the request can be canceled.
+type testCancelableRoundTripper struct {
+	cancelable bool
+}
+
+func (rt *testCancelableRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	rt.cancelable = req.Context().Done() != nil
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       ioutil.NopCloser(strings.NewReader("{}")),
+	}, nil
+}
+
+func TestRequestTimeoutRoundTripper(t *testing.T) {
+	inner := &testCancelableRoundTripper{}
+	rt := withRequestTimeout(inner, time.Minute)
+
+	req, err := http.NewRequest("POST", "http://localhost/v2.0/ports", strings.NewReader(`{"port": {}}`))
+	assert.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+
+	_, err = rt.RoundTrip(req)
+	assert.NoError(t, err)
+	assert.True(t, inner.cancelable)
+
+	// An image upload is not limited.
+	req, err = http.NewRequest("PUT", "http://localhost/v2/images/id/file", strings.NewReader("data"))
+	assert.NoError(t, err)
+	req.Header.Set("Content-Type", "application/octet-stream")
+
+	_, err = rt.RoundTrip(req)
+	assert.NoError(t, err)
+	assert.False(t, inner.cancelable)
+}
+
+func TestRequestTimeoutRoundTripper_timeout(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	done := make(chan struct{})
+	defer close(done)
+
+	th.Mux.HandleFunc("/slow", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	// The slot of the semaphore is acquired before the timeout starts.
+	rt := newSemaphoreRoundTripper(&osClient.RoundTripper{Rt: &http.Transport{}}, 1)
+	client := http.Client{Transport: withRequestTimeout(rt, 100*time.Millisecond)}
+
+	start := time.Now()
+	_, err := client.Get(th.Endpoint() + "slow")
+	assert.Error(t, err)
+	assert.Less(t, int64(time.Since(start)), int64(5*time.Second))
+}
+
+func TestVersionChoicesRoundTripper(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusMultipleChoices)
+		fmt.Fprint(w, `
+			{
+				"versions": {
+					"values": [
+						{
+							"id": "v3.0",
+							"status": "stable",
+							"links": [
+								{
+									"rel": "self",
+									"href": "http://10.0.0.1:5000/v3/"
+								}
+							]
+						}
+					]
+				}
+			}
+		`)
+	}))
+	defer server.Close()
+
+	client := &gophercloud.ProviderClient{
+		IdentityBase:     server.URL + "/identity/",
+		IdentityEndpoint: server.URL + "/identity/",
+		HTTPClient: http.Client{
+			Transport: &versionChoicesRoundTripper{rt: http.DefaultTransport},
+		},
+	}
+
+	versions := []*osUtils.Version{
+		{ID: "v3.0", Priority: 30, Suffix: "/v3/"},
+	}
+
+	version, endpoint, err := osUtils.ChooseVersion(client, versions)
+	assert.NoError(t, err)
+	assert.Equal(t, "v3.0", version.ID)
+	assert.Equal(t, server.URL+"/identity/v3/", endpoint)
+}
+
+func TestRewriteVersionChoices(t *testing.T) {
+	base, err := url.Parse("https://cloud.example.com/identity")
+	assert.NoError(t, err)
+
+	body := []byte(`{"versions":{"values":[{"id":"v2.0","links":[{"rel":"self","href":"https://cloud.example.com/identity/v2.0/"}]},{"id":"v3.14","links":[{"rel":"describedby","href":"https://docs.openstack.org/"},{"rel":"self","href":"http://10.0.0.1:5000/v3/"}]}]}}`)
+	expected := `{"versions":{"values":[{"id":"v2.0","links":[{"href":"https://cloud.example.com/identity/v2.0/","rel":"self"}]},{"id":"v3.14","links":[{"href":"https://docs.openstack.org/","rel":"describedby"},{"href":"https://cloud.example.com/identity/v3/","rel":"self"}]}]}}`
+
+	actual, ok := rewriteVersionChoices(body, base)
+	assert.True(t, ok)
+	assert.Equal(t, expected, string(actual))
+
+	_, ok = rewriteVersionChoices([]byte(`{"choices":[]}`), base)
+	assert.False(t, ok)
+
+	_, ok = rewriteVersionChoices([]byte(`<html></html>`), base)
+	assert.False(t, ok)
+}
+
+func TestGetRegion_cloudsYAML(t *testing.T) {
+	dir, err := ioutil.TempDir("", "clouds")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	cloudsYAML := filepath.Join(dir, "clouds.yaml")
+	err = ioutil.WriteFile(cloudsYAML, []byte(`clouds:
+  mycloud:
+    auth:
+      auth_url: http://localhost:5000/v3
+      username: admin
+      password: secret
+      project_name: admin
+      user_domain_name: Default
+      project_domain_name: Default
+    region_name: RegionTwo
+`), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.Setenv("OS_CLIENT_CONFIG_FILE", os.Getenv("OS_CLIENT_CONFIG_FILE"))
+	os.Setenv("OS_CLIENT_CONFIG_FILE", cloudsYAML)
+	defer os.Setenv("OS_REGION_NAME", os.Getenv("OS_REGION_NAME"))
+	os.Unsetenv("OS_REGION_NAME")
+
+	resourceSchema := map[string]*schema.Schema{
+		"region": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	}
+
+	configure := func(raw map[string]interface{}) *Config {
+		d := schema.TestResourceDataRaw(t, Provider().(*schema.Provider).Schema, raw)
+		meta, err := configureProvider(d, "", context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		return meta.(*Config)
+	}
+
+	config := configure(map[string]interface{}{
+		"cloud":        "mycloud",
+		"delayed_auth": true,
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{})
+	assert.Equal(t, "RegionTwo", GetRegion(d, config))
+
+	d = schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{
+		"region": "RegionOne",
+	})
+	assert.Equal(t, "RegionOne", GetRegion(d, config))
+
+	config = configure(map[string]interface{}{
+		"cloud":        "mycloud",
+		"region":       "RegionThree",
+		"delayed_auth": true,
+	})
+
+	d = schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{})
+	assert.Equal(t, "RegionThree", GetRegion(d, config))
+}
+
+func TestParseQuotaID(t *testing.T) {
+	projectID, region, err := parseQuotaID("openstack_networking_quota_v2", "3a4f1b47c6a0413f84e7d47e7e1b7a3b")
+	assert.NoError(t, err)
+	assert.Equal(t, "3a4f1b47c6a0413f84e7d47e7e1b7a3b", projectID)
+	assert.Equal(t, "", region)
+
+	projectID, region, err = parseQuotaID("openstack_networking_quota_v2", "3a4f1b47c6a0413f84e7d47e7e1b7a3b/RegionOne")
+	assert.NoError(t, err)
+	assert.Equal(t, "3a4f1b47c6a0413f84e7d47e7e1b7a3b", projectID)
+	assert.Equal(t, "RegionOne", region)
+
+	projectID, region, err = parseQuotaID("openstack_networking_quota_v2", "3a4f1b47c6a0413f84e7d47e7e1b7a3b/region/one")
+	assert.NoError(t, err)
+	assert.Equal(t, "3a4f1b47c6a0413f84e7d47e7e1b7a3b", projectID)
+	assert.Equal(t, "region/one", region)
+
+	for _, id := range []string{"", "/", "/RegionOne", "3a4f1b47c6a0413f84e7d47e7e1b7a3b/"} {
+		_, _, err = parseQuotaID("openstack_networking_quota_v2", id)
+		assert.Error(t, err, id)
+	}
+}
+
+func TestQuotaID(t *testing.T) {
+	id, err := quotaID("openstack_networking_quota_v2", "3a4f1b47c6a0413f84e7d47e7e1b7a3b", "RegionOne")
+	assert.NoError(t, err)
+	assert.Equal(t, "3a4f1b47c6a0413f84e7d47e7e1b7a3b/RegionOne", id)
+
+	id, err = quotaID("openstack_networking_quota_v2", "3a4f1b47c6a0413f84e7d47e7e1b7a3b/RegionTwo", "RegionOne")
+	assert.NoError(t, err)
+	assert.Equal(t, "3a4f1b47c6a0413f84e7d47e7e1b7a3b/RegionTwo", id)
+
+	id, err = quotaID("openstack_networking_quota_v2", "3a4f1b47c6a0413f84e7d47e7e1b7a3b/region/two", "RegionOne")
+	assert.NoError(t, err)
+	assert.Equal(t, "3a4f1b47c6a0413f84e7d47e7e1b7a3b/region/two", id)
+
+	_, err = quotaID("openstack_networking_quota_v2", "/RegionOne", "RegionOne")
+	assert.Error(t, err)
+}